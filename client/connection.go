@@ -75,6 +75,9 @@ type ConnectionArgs struct {
 	CachePath   string
 	CacheExpiry time.Duration
 
+	// Armored GPG keyring that simplestreams indexes must be signed with (unsigned indexes are allowed if empty)
+	SimpleStreamsKeyring string
+
 	// Temp storage.
 	TempPath string
 }
@@ -306,6 +309,14 @@ func ConnectSimpleStreams(uri string, args *ConnectionArgs) (ImageServer, error)
 	ssClient := simplestreams.NewClient(uri, *httpClient, args.UserAgent)
 	server.ssClient = ssClient
 
+	// Require signed indexes if a keyring was provided.
+	if args.SimpleStreamsKeyring != "" {
+		err = ssClient.SetKeyring(args.SimpleStreamsKeyring)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Setup the cache
 	if args.CachePath != "" {
 		if !util.PathExists(args.CachePath) {
@@ -1,6 +1,7 @@
 package incus
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -119,7 +120,12 @@ func (r *ProtocolIncus) GetImage(fingerprint string) (*api.Image, string, error)
 
 // GetImageFile downloads an image from the server, returning an ImageFileRequest struct.
 func (r *ProtocolIncus) GetImageFile(fingerprint string, req ImageFileRequest) (*ImageFileResponse, error) {
-	return r.GetPrivateImageFile(fingerprint, "", req)
+	return r.GetImageFileContext(context.Background(), fingerprint, req)
+}
+
+// GetImageFileContext is similar to GetImageFile but the download is bound to the provided context.
+func (r *ProtocolIncus) GetImageFileContext(ctx context.Context, fingerprint string, req ImageFileRequest) (*ImageFileResponse, error) {
+	return r.getPrivateImageFile(ctx, fingerprint, "", req)
 }
 
 // GetImageSecret is a helper around CreateImageSecret that returns a secret for the image.
@@ -169,6 +175,10 @@ func (r *ProtocolIncus) GetPrivateImage(fingerprint string, secret string) (*api
 
 // GetPrivateImageFile is similar to GetImageFile but allows passing a secret download token.
 func (r *ProtocolIncus) GetPrivateImageFile(fingerprint string, secret string, req ImageFileRequest) (*ImageFileResponse, error) {
+	return r.getPrivateImageFile(context.Background(), fingerprint, secret, req)
+}
+
+func (r *ProtocolIncus) getPrivateImageFile(ctx context.Context, fingerprint string, secret string, req ImageFileRequest) (*ImageFileResponse, error) {
 	// Quick checks.
 	if req.MetaFile == nil && req.RootfsFile == nil {
 		return nil, errors.New("No file requested")
@@ -189,7 +199,7 @@ func (r *ProtocolIncus) GetPrivateImageFile(fingerprint string, secret string, r
 		// Setup the HTTP client
 		devIncusHTTP, err := unixHTTPClient(nil, "/dev/incus/sock")
 		if err == nil {
-			resp, err := incusDownloadImage(fingerprint, unixURI, r.httpUserAgent, devIncusHTTP.Do, req)
+			resp, err := incusDownloadImage(ctx, fingerprint, unixURI, r.httpUserAgent, devIncusHTTP.Do, req)
 			if err == nil {
 				return resp, nil
 			}
@@ -212,15 +222,15 @@ func (r *ProtocolIncus) GetPrivateImageFile(fingerprint string, secret string, r
 	httpTransport.ResponseHeaderTimeout = 30 * time.Second
 	httpClient.Transport = httpTransport
 
-	return incusDownloadImage(fingerprint, uri, r.httpUserAgent, r.DoHTTP, req)
+	return incusDownloadImage(ctx, fingerprint, uri, r.httpUserAgent, r.DoHTTP, req)
 }
 
-func incusDownloadImage(fingerprint string, uri string, userAgent string, do func(*http.Request) (*http.Response, error), req ImageFileRequest) (*ImageFileResponse, error) {
+func incusDownloadImage(ctx context.Context, fingerprint string, uri string, userAgent string, do func(*http.Request) (*http.Response, error), req ImageFileRequest) (*ImageFileResponse, error) {
 	// Prepare the response
 	resp := ImageFileResponse{}
 
 	// Prepare the download request
-	request, err := http.NewRequest("GET", uri, nil)
+	request, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
 	if err != nil {
 		return nil, err
 	}
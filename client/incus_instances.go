@@ -820,6 +820,7 @@ func (r *ProtocolIncus) CopyInstance(source InstanceServer, instance api.Instanc
 		req.Source.Refresh = args.Refresh
 		req.Source.RefreshExcludeOlder = args.RefreshExcludeOlder
 		req.Source.AllowInconsistent = args.AllowInconsistent
+		req.Source.Snapshots = args.Snapshots
 	}
 
 	if req.Source.Live {
@@ -847,6 +848,10 @@ func (r *ProtocolIncus) CopyInstance(source InstanceServer, instance api.Instanc
 			req.Source.Project = sourceInfo.Project
 		}
 
+		if len(req.Source.Snapshots) > 0 && !r.HasExtension("instance_copy_snapshot_selection") {
+			return nil, errors.New("The server is missing the required \"instance_copy_snapshot_selection\" API extension")
+		}
+
 		// Local copy source fields
 		req.Source.Type = "copy"
 		req.Source.Source = instance.Name
@@ -871,14 +876,22 @@ func (r *ProtocolIncus) CopyInstance(source InstanceServer, instance api.Instanc
 		return &rop, nil
 	}
 
+	if len(req.Source.Snapshots) > 0 && !source.HasExtension("instance_copy_snapshot_selection") {
+		return nil, errors.New("The source server is missing the required \"instance_copy_snapshot_selection\" API extension")
+	}
+
 	// Source request
 	sourceReq := api.InstancePost{
 		Migration:         true,
 		Live:              req.Source.Live,
 		InstanceOnly:      req.Source.InstanceOnly,
 		AllowInconsistent: req.Source.AllowInconsistent,
+		Snapshots:         req.Source.Snapshots,
 	}
 
+	// The snapshot selection is applied by the migration source.
+	req.Source.Snapshots = nil
+
 	// When dependent volumes are supported, Devices are sent to the
 	// migration source to allow overriding the per-device pools.
 	if source.HasExtension("dependent") {
@@ -1912,6 +1925,10 @@ func (r *ProtocolIncus) CopyInstanceSnapshot(source InstanceServer, instanceName
 			return nil, errors.New("The source server is missing the required \"container_push_target\" API extension")
 		}
 
+		if args.Refresh && !r.HasExtension("instance_refresh_from_snapshot") {
+			return nil, errors.New("The target server is missing the required \"instance_refresh_from_snapshot\" API extension")
+		}
+
 		// Allow overriding the target name
 		if args.Name != "" {
 			req.Name = args.Name
@@ -1948,6 +1965,11 @@ func (r *ProtocolIncus) CopyInstanceSnapshot(source InstanceServer, instanceName
 		req.Source.Type = "copy"
 		req.Source.Source = fmt.Sprintf("%s/%s", cName, sName)
 
+		if args != nil {
+			req.Source.Refresh = args.Refresh
+			req.Source.RefreshExcludeOlder = args.RefreshExcludeOlder
+		}
+
 		// Copy the instance
 		op, err := r.CreateInstance(req)
 		if err != nil {
@@ -1968,6 +1990,10 @@ func (r *ProtocolIncus) CopyInstanceSnapshot(source InstanceServer, instanceName
 		return &rop, nil
 	}
 
+	if args != nil && args.Refresh {
+		return nil, errors.New("Refreshing from a snapshot is only supported within the same server")
+	}
+
 	// If deadling with migration, we need to set the type.
 	if source.HasExtension("virtual-machines") {
 		inst, _, err := source.GetInstance(instanceName)
@@ -43,6 +43,27 @@ func (r *ProtocolIncus) GetNetworkZones() ([]api.NetworkZone, error) {
 	return zones, nil
 }
 
+// GetNetworkZonesWithFilter returns a filtered list of Network zone structs.
+func (r *ProtocolIncus) GetNetworkZonesWithFilter(filters []string) ([]api.NetworkZone, error) {
+	if !r.HasExtension("network_dns") {
+		return nil, errors.New(`The server is missing the required "network_dns" API extension`)
+	}
+
+	zones := []api.NetworkZone{}
+
+	v := url.Values{}
+	v.Set("recursion", "1")
+	v.Set("filter", parseFilters(filters))
+
+	// Fetch the raw value.
+	_, err := r.queryStruct("GET", fmt.Sprintf("/network-zones?%s", v.Encode()), nil, "", &zones)
+	if err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
 // GetNetworkZonesAllProjects returns a list of network zones across all projects as NetworkZone structs.
 func (r *ProtocolIncus) GetNetworkZonesAllProjects() ([]api.NetworkZone, error) {
 	err := r.CheckExtension("network_zones_all_projects")
@@ -59,6 +80,28 @@ func (r *ProtocolIncus) GetNetworkZonesAllProjects() ([]api.NetworkZone, error)
 	return zones, nil
 }
 
+// GetNetworkZonesAllProjectsWithFilter returns a filtered list of network zones across all projects as NetworkZone structs.
+func (r *ProtocolIncus) GetNetworkZonesAllProjectsWithFilter(filters []string) ([]api.NetworkZone, error) {
+	err := r.CheckExtension("network_zones_all_projects")
+	if err != nil {
+		return nil, errors.New(`The server is missing the required "network_zones_all_projects" API extension`)
+	}
+
+	zones := []api.NetworkZone{}
+
+	v := url.Values{}
+	v.Set("recursion", "1")
+	v.Set("all-projects", "true")
+	v.Set("filter", parseFilters(filters))
+
+	_, err = r.queryStruct("GET", fmt.Sprintf("/network-zones?%s", v.Encode()), nil, "", &zones)
+	if err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
 // GetNetworkZone returns a Network zone entry for the provided name.
 func (r *ProtocolIncus) GetNetworkZone(name string) (*api.NetworkZone, string, error) {
 	if !r.HasExtension("network_dns") {
@@ -347,6 +347,46 @@ func (r *ProtocolIncus) CreateStoragePoolVolumeSnapshot(pool string, volumeType
 	return op, nil
 }
 
+// PruneStoragePoolVolumeSnapshots deletes the expired snapshots of a storage volume.
+func (r *ProtocolIncus) PruneStoragePoolVolumeSnapshots(pool string, volumeType string, volumeName string) (Operation, error) {
+	if !r.HasExtension("storage_volume_snapshots_prune") {
+		return nil, errors.New("The server is missing the required \"storage_volume_snapshots_prune\" API extension")
+	}
+
+	// Send the request
+	path := fmt.Sprintf("/storage-pools/%s/volumes/%s/%s/snapshots?action=prune",
+		url.PathEscape(pool),
+		url.PathEscape(volumeType),
+		url.PathEscape(volumeName))
+	op, _, err := r.queryOperation("POST", path, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// PreviewStoragePoolVolumeSnapshotExpiry returns the expiry a snapshot created with the given request would get.
+func (r *ProtocolIncus) PreviewStoragePoolVolumeSnapshotExpiry(pool string, volumeType string, volumeName string, snapshot api.StorageVolumeSnapshotsPost) (*api.StorageVolumeSnapshotExpiry, error) {
+	if !r.HasExtension("storage_volume_snapshot_preview_expiry") {
+		return nil, errors.New("The server is missing the required \"storage_volume_snapshot_preview_expiry\" API extension")
+	}
+
+	expiry := api.StorageVolumeSnapshotExpiry{}
+
+	// Send the request
+	path := fmt.Sprintf("/storage-pools/%s/volumes/%s/%s/snapshots?action=preview-expiry",
+		url.PathEscape(pool),
+		url.PathEscape(volumeType),
+		url.PathEscape(volumeName))
+	_, err := r.queryStruct("POST", path, snapshot, "", &expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expiry, nil
+}
+
 // GetStoragePoolVolumeSnapshotNames returns a list of snapshot names for the
 // storage volume.
 func (r *ProtocolIncus) GetStoragePoolVolumeSnapshotNames(pool string, volumeType string, volumeName string) ([]string, error) {
@@ -642,6 +682,10 @@ func (r *ProtocolIncus) CopyStoragePoolVolume(pool string, source InstanceServer
 		return nil, errors.New("The target server is missing the required \"custom_volume_refresh_exclude_older_snapshots\" API extension")
 	}
 
+	if args != nil && args.VerifyTransfer && !r.HasExtension("storage_volume_migration_verify") {
+		return nil, errors.New("The target server is missing the required \"storage_volume_migration_verify\" API extension")
+	}
+
 	req := api.StorageVolumesPost{
 		Name: args.Name,
 		Type: volume.Type,
@@ -652,6 +696,7 @@ func (r *ProtocolIncus) CopyStoragePoolVolume(pool string, source InstanceServer
 			VolumeOnly:          args.VolumeOnly,
 			Refresh:             args.Refresh,
 			RefreshExcludeOlder: args.RefreshExcludeOlder,
+			VerifyTransfer:      args.VerifyTransfer,
 		},
 	}
 
@@ -710,6 +755,10 @@ func (r *ProtocolIncus) CopyStoragePoolVolume(pool string, source InstanceServer
 		return nil, errors.New("The server is missing the required \"storage_api_remote_volume_handling\" API extension")
 	}
 
+	if args != nil && args.VerifyTransfer && !source.HasExtension("storage_volume_migration_verify") {
+		return nil, errors.New("The source server is missing the required \"storage_volume_migration_verify\" API extension")
+	}
+
 	sourceReq := api.StorageVolumePost{
 		Migration: true,
 		Name:      volume.Name,
@@ -718,6 +767,7 @@ func (r *ProtocolIncus) CopyStoragePoolVolume(pool string, source InstanceServer
 
 	if args != nil {
 		sourceReq.VolumeOnly = args.VolumeOnly
+		sourceReq.Source.VerifyTransfer = args.VerifyTransfer
 	}
 
 	// Push mode migration
@@ -864,6 +914,14 @@ func (r *ProtocolIncus) MoveStoragePoolVolume(pool string, source InstanceServer
 		req.Project = args.Project
 	}
 
+	if args.Refresh {
+		if !r.HasExtension("storage_volume_move_refresh") {
+			return nil, errors.New("The server is missing the required \"storage_volume_move_refresh\" API extension")
+		}
+
+		req.Refresh = true
+	}
+
 	// Send the request
 	op, _, err := r.queryOperation("POST", fmt.Sprintf("/storage-pools/%s/volumes/%s/%s", url.PathEscape(sourcePool), url.PathEscape(volume.Type), volume.Name), req, "")
 	if err != nil {
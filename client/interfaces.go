@@ -55,6 +55,7 @@ type ImageServer interface {
 
 	GetImage(fingerprint string) (image *api.Image, ETag string, err error)
 	GetImageFile(fingerprint string, req ImageFileRequest) (resp *ImageFileResponse, err error)
+	GetImageFileContext(ctx context.Context, fingerprint string, req ImageFileRequest) (resp *ImageFileResponse, err error)
 	GetImageSecret(fingerprint string) (secret string, err error)
 
 	GetPrivateImage(fingerprint string, secret string) (image *api.Image, ETag string, err error)
@@ -265,8 +266,10 @@ type InstanceServer interface {
 
 	// Network zone functions ("network_dns" API extension)
 	GetNetworkZonesAllProjects() (zones []api.NetworkZone, err error)
+	GetNetworkZonesAllProjectsWithFilter(filters []string) (zones []api.NetworkZone, err error)
 	GetNetworkZoneNames() (names []string, err error)
 	GetNetworkZones() (zones []api.NetworkZone, err error)
+	GetNetworkZonesWithFilter(filters []string) (zones []api.NetworkZone, err error)
 	GetNetworkZone(name string) (zone *api.NetworkZone, ETag string, err error)
 	CreateNetworkZone(zone api.NetworkZonesPost) (err error)
 	UpdateNetworkZone(name string, zone api.NetworkZonePut, ETag string) (err error)
@@ -396,6 +399,12 @@ type InstanceServer interface {
 	RenameStoragePoolVolumeSnapshot(pool string, volumeType string, volumeName string, snapshotName string, snapshot api.StorageVolumeSnapshotPost) (op Operation, err error)
 	UpdateStoragePoolVolumeSnapshot(pool string, volumeType string, volumeName string, snapshotName string, volume api.StorageVolumeSnapshotPut, ETag string) (err error)
 
+	// Storage volume snapshot pruning ("storage_volume_snapshots_prune" API extension)
+	PruneStoragePoolVolumeSnapshots(pool string, volumeType string, volumeName string) (op Operation, err error)
+
+	// Storage volume snapshot expiry preview ("storage_volume_snapshot_preview_expiry" API extension)
+	PreviewStoragePoolVolumeSnapshotExpiry(pool string, volumeType string, volumeName string, snapshot api.StorageVolumeSnapshotsPost) (expiry *api.StorageVolumeSnapshotExpiry, err error)
+
 	// Storage volume backup functions ("custom_volume_backup" API extension)
 	GetStorageVolumeBackupNames(pool string, volName string) (names []string, err error)
 	GetStorageVolumeBackups(pool string, volName string) (backups []api.StorageVolumeBackup, err error)
@@ -532,6 +541,26 @@ type ImageFileRequest struct {
 	// Path retriever for image delta downloads
 	// If set, it must return the path to the image file or an empty string if not available
 	DeltaSourceRetriever func(fingerprint string, file string) string
+
+	// Whether to download the metadata and rootfs files concurrently (simplestreams only)
+	ParallelDownload bool
+
+	// Progress handler for the metadata file (defaults to ProgressHandler)
+	MetaProgressHandler func(progress ioprogress.ProgressData)
+
+	// Whether to check the rootfs hash after applying deltas (simplestreams only)
+	VerifyAfterApply bool
+
+	// Whether to resume from data already present in the target files, which must support Truncate (simplestreams only)
+	Resume bool
+
+	// Transport to download the files over, either "http" or "https" (simplestreams only)
+	// If empty, http is tried first with a fallback to https
+	Transport string
+
+	// Number of times to retry a failed file download with exponential backoff (simplestreams only)
+	// If zero, a small default is used; a negative value disables retries
+	Retries int
 }
 
 // The ImageFileResponse struct is used as the response for image downloads.
@@ -590,6 +619,9 @@ type StoragePoolVolumeCopyArgs struct {
 
 	// API extension: custom_volume_refresh_exclude_older_snapshots
 	RefreshExcludeOlder bool
+
+	// API extension: storage_volume_migration_verify
+	VerifyTransfer bool
 }
 
 // The StoragePoolVolumeMoveArgs struct is used to pass additional options
@@ -652,6 +684,10 @@ type InstanceCopyArgs struct {
 
 	// API extension: instance_allow_inconsistent_copy
 	AllowInconsistent bool
+
+	// API extension: instance_copy_snapshot_selection
+	// If set, only the listed snapshots will be copied
+	Snapshots []string
 }
 
 // The InstanceSnapshotCopyArgs struct is used to pass additional options during instance copy.
@@ -665,6 +701,14 @@ type InstanceSnapshotCopyArgs struct {
 	// API extension: container_snapshot_stateful_migration
 	// If set, the instance running state will be transferred (live migration)
 	Live bool
+
+	// API extension: instance_refresh_from_snapshot
+	// If set, the existing target instance will be refreshed from the snapshot
+	Refresh bool
+
+	// API extension: instance_refresh_from_snapshot
+	// If set, source snapshots earlier than latest target snapshot are excluded
+	RefreshExcludeOlder bool
 }
 
 // The InstanceConsoleArgs struct is used to pass additional options during a
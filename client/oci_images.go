@@ -129,8 +129,11 @@ func (r *ProtocolOCI) GetImage(fingerprint string) (*api.Image, string, error) {
 
 // GetImageFile downloads an image from the server, returning an ImageFileResponse struct.
 func (r *ProtocolOCI) GetImageFile(fingerprint string, req ImageFileRequest) (*ImageFileResponse, error) {
-	ctx := context.Background()
+	return r.GetImageFileContext(context.Background(), fingerprint, req)
+}
 
+// GetImageFileContext is similar to GetImageFile but the download is bound to the provided context.
+func (r *ProtocolOCI) GetImageFileContext(ctx context.Context, fingerprint string, req ImageFileRequest) (*ImageFileResponse, error) {
 	// Get the cached entry.
 	info, ok := r.cache[fingerprint]
 	if !ok {
@@ -6,14 +6,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/lxc/incus/v7/shared/api"
+	"github.com/lxc/incus/v7/shared/cancel"
+	"github.com/lxc/incus/v7/shared/ioprogress"
 	"github.com/lxc/incus/v7/shared/logger"
 	"github.com/lxc/incus/v7/shared/simplestreams"
 	"github.com/lxc/incus/v7/shared/subprocess"
@@ -27,6 +33,23 @@ func (r *ProtocolSimpleStreams) GetImages() ([]api.Image, error) {
 	return r.ssClient.ListImages()
 }
 
+// GetImagesByArchitecture returns the list of available images for the given architecture.
+func (r *ProtocolSimpleStreams) GetImagesByArchitecture(arch string) ([]api.Image, error) {
+	images, err := r.ssClient.ListImages()
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []api.Image{}
+	for _, img := range images {
+		if img.Architecture == arch {
+			filtered = append(filtered, img)
+		}
+	}
+
+	return filtered, nil
+}
+
 // GetImagesAllProjects returns a list of available images as Image structs.
 func (r *ProtocolSimpleStreams) GetImagesAllProjects() ([]api.Image, error) {
 	return r.GetImages()
@@ -71,11 +94,20 @@ func (r *ProtocolSimpleStreams) GetImage(fingerprint string) (*api.Image, string
 
 // GetImageFile downloads an image from the server, returning an ImageFileResponse struct.
 func (r *ProtocolSimpleStreams) GetImageFile(fingerprint string, req ImageFileRequest) (*ImageFileResponse, error) {
+	return r.GetImageFileContext(context.Background(), fingerprint, req)
+}
+
+// GetImageFileContext is similar to GetImageFile but the download is bound to the provided context.
+func (r *ProtocolSimpleStreams) GetImageFileContext(ctx context.Context, fingerprint string, req ImageFileRequest) (*ImageFileResponse, error) {
 	// Quick checks.
 	if req.MetaFile == nil && req.RootfsFile == nil {
 		return nil, errors.New("No file requested")
 	}
 
+	if !slices.Contains([]string{"", "http", "https"}, req.Transport) {
+		return nil, fmt.Errorf("Invalid transport %q", req.Transport)
+	}
+
 	// Attempt to download from host
 	if util.PathExists("/dev/incus/sock") && os.Geteuid() == 0 {
 		unixURI := fmt.Sprintf("http://unix.socket/1.0/images/%s/export", url.PathEscape(fingerprint))
@@ -83,7 +115,7 @@ func (r *ProtocolSimpleStreams) GetImageFile(fingerprint string, req ImageFileRe
 		// Setup the HTTP client
 		devIncusHTTP, err := unixHTTPClient(nil, "/dev/incus/sock")
 		if err == nil {
-			resp, err := incusDownloadImage(fingerprint, unixURI, r.httpUserAgent, devIncusHTTP.Do, req)
+			resp, err := incusDownloadImage(ctx, fingerprint, unixURI, r.httpUserAgent, devIncusHTTP.Do, req)
 			if err == nil {
 				return resp, nil
 			}
@@ -115,65 +147,121 @@ func (r *ProtocolSimpleStreams) GetImageFile(fingerprint string, req ImageFileRe
 	resp := ImageFileResponse{}
 
 	// Download function
-	download := func(path string, filename string, hash string, target io.WriteSeeker) (int64, error) {
-		// Try over http
-		uri, err := urlJoinPathAbsolute(fmt.Sprintf("http://%s", strings.TrimPrefix(r.httpHost, "https://")), path)
+	download := func(ctx context.Context, path string, filename string, hash string, target io.ReadWriteSeeker, progressHandler func(ioprogress.ProgressData)) (int64, error) {
+		downloadFile := func(uri string) (int64, error) {
+			if req.Resume {
+				return util.DownloadFileHashResume(ctx, &httpClient, r.httpUserAgent, progressHandler, req.Canceler, filename, uri, hash, sha256.New(), target)
+			}
+
+			return util.DownloadFileHash(ctx, &httpClient, r.httpUserAgent, progressHandler, req.Canceler, filename, uri, hash, sha256.New(), target)
+		}
+
+		httpURI, err := urlJoinPathAbsolute(fmt.Sprintf("http://%s", strings.TrimPrefix(r.httpHost, "https://")), path)
 		if err != nil {
 			return -1, err
 		}
 
-		size, err := util.DownloadFileHash(context.TODO(), &httpClient, r.httpUserAgent, req.ProgressHandler, req.Canceler, filename, uri, hash, sha256.New(), target)
+		httpsURI, err := urlJoinPathAbsolute(r.httpHost, path)
 		if err != nil {
-			// Handle cancellation
-			if err.Error() == "net/http: request canceled" {
-				return -1, err
-			}
+			return -1, err
+		}
 
-			// Try over https
-			uri, err := urlJoinPathAbsolute(r.httpHost, path)
-			if err != nil {
-				return -1, err
-			}
+		// Try over http first and then over https, unless a transport was requested.
+		var uris []string
+		switch req.Transport {
+		case "http":
+			uris = []string{httpURI}
+		case "https":
+			uris = []string{httpsURI}
+		default:
+			uris = []string{httpURI, httpsURI}
+		}
+
+		retries := req.Retries
+		if retries == 0 {
+			retries = defaultDownloadRetries
+		}
+
+		for attempt := 0; ; attempt++ {
+			for i, uri := range uris {
+				size, err := downloadFile(uri)
+				if err == nil {
+					return size, nil
+				}
+
+				// Handle cancellation
+				if err.Error() == "net/http: request canceled" || errors.Is(err, context.Canceled) {
+					return -1, err
+				}
+
+				if i < len(uris)-1 {
+					continue
+				}
 
-			size, err = util.DownloadFileHash(context.TODO(), &httpClient, r.httpUserAgent, req.ProgressHandler, req.Canceler, filename, uri, hash, sha256.New(), target)
-			if err != nil {
 				if errors.Is(err, util.ErrNotFound) {
 					logger.Info("Unable to download file by hash, invalidate potentially outdated cache", logger.Ctx{"filename": filename, "uri": uri, "hash": hash})
 					r.ssClient.InvalidateCache()
+
+					return -1, err
 				}
 
-				return -1, err
+				if attempt >= retries || !isTransientDownloadError(err) {
+					return -1, err
+				}
+
+				// Back off before retrying, doubling the delay each time.
+				delay := time.Second << attempt
+				logger.Debug("Failed downloading file, retrying", logger.Ctx{"filename": filename, "uri": uri, "delay": delay, "err": err})
+
+				err = cancel.CancelableWait(ctx, req.Canceler, delay)
+				if err != nil {
+					return -1, err
+				}
 			}
 		}
+	}
 
-		return size, nil
+	metaProgressHandler := req.ProgressHandler
+	if req.MetaProgressHandler != nil {
+		metaProgressHandler = req.MetaProgressHandler
 	}
 
 	// Download the Incus image file
-	meta, ok := files["meta"]
-	if ok && req.MetaFile != nil {
-		size, err := download(meta.Path, "metadata", meta.Sha256, req.MetaFile)
+	downloadMeta := func(ctx context.Context) error {
+		meta, ok := files["meta"]
+		if !ok || req.MetaFile == nil {
+			return nil
+		}
+
+		size, err := download(ctx, meta.Path, "metadata", meta.Sha256, req.MetaFile, metaProgressHandler)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		parts := strings.Split(meta.Path, "/")
 		resp.MetaName = parts[len(parts)-1]
 		resp.MetaSize = size
+
+		return nil
 	}
 
 	// Download the rootfs
-	rootfs, ok := files["root"]
-	if ok && req.RootfsFile != nil {
+	downloadRootfs := func(ctx context.Context) error {
+		rootfs, ok := files["root"]
+		if !ok || req.RootfsFile == nil {
+			return nil
+		}
+
 		// Look for deltas (requires xdelta3)
 		downloaded := false
 		_, err := exec.LookPath("xdelta3")
 		if err == nil && req.DeltaSourceRetriever != nil {
-			applyDelta := func(file simplestreams.DownloadableFile, srcPath string, target io.Writer) (int64, error) {
+			// Download a delta and apply it on top of srcPath, returning the path to the patched file.
+			applyDelta := func(file simplestreams.DownloadableFile, srcPath string) (string, error) {
 				// Create temporary file for the delta
 				deltaFile, err := os.CreateTemp(r.tempPath, "incus_image_")
 				if err != nil {
-					return -1, err
+					return "", err
 				}
 
 				defer logger.WarnOnError(deltaFile.Close, "Failed to close temporary file")
@@ -181,51 +269,67 @@ func (r *ProtocolSimpleStreams) GetImageFile(fingerprint string, req ImageFileRe
 				defer logger.WarnOnError(func() error { return os.Remove(deltaFile.Name()) }, "Failed to remove temporary file")
 
 				// Download the delta
-				_, err = download(file.Path, "rootfs delta", file.Sha256, deltaFile)
+				_, err = download(ctx, file.Path, "rootfs delta", file.Sha256, deltaFile, req.ProgressHandler)
 				if err != nil {
-					return -1, err
+					return "", err
 				}
 
-				// Create temporary file for the delta
+				// Create temporary file for the patched rootfs
 				patchedFile, err := os.CreateTemp(r.tempPath, "incus_image_")
 				if err != nil {
-					return -1, err
+					return "", err
 				}
 
 				defer logger.WarnOnError(patchedFile.Close, "Failed to close temporary file")
 
-				defer logger.WarnOnError(func() error { return os.Remove(patchedFile.Name()) }, "Failed to remove temporary file")
-
 				// Apply it
 				_, err = subprocess.RunCommand("xdelta3", "-f", "-d", "-s", srcPath, deltaFile.Name(), patchedFile.Name())
 				if err != nil {
-					return -1, err
+					_ = os.Remove(patchedFile.Name())
+					return "", err
 				}
 
-				// Copy to the target
-				size, err := util.SafeCopy(req.RootfsFile, patchedFile)
+				return patchedFile.Name(), nil
+			}
+
+			srcPath, chain := r.resolveDeltaChain(fingerprint, files, req.DeltaSourceRetriever)
+			if len(chain) > 0 {
+				// Apply the deltas in sequence, cleaning up intermediate results as we go.
+				for _, step := range chain {
+					patchedPath, err := applyDelta(step.file, srcPath)
+					if err != nil {
+						return err
+					}
+
+					defer logger.WarnOnError(func() error { return os.Remove(patchedPath) }, "Failed to remove temporary file")
+
+					srcPath = patchedPath
+				}
+
+				patchedFile, err := os.Open(srcPath)
 				if err != nil {
-					return -1, err
+					return err
 				}
 
-				return size, nil
-			}
+				defer logger.WarnOnError(patchedFile.Close, "Failed to close temporary file")
 
-			for filename, file := range files {
-				_, srcFingerprint, prefixFound := strings.Cut(filename, "root.delta-")
-				if !prefixFound {
-					continue
+				// Copy to the target, hashing the patched rootfs along the way if requested.
+				var target io.Writer = req.RootfsFile
+				patchedHash := sha256.New()
+				if req.VerifyAfterApply {
+					target = io.MultiWriter(req.RootfsFile, patchedHash)
 				}
 
-				// Check if we have the source file for the delta
-				srcPath := req.DeltaSourceRetriever(srcFingerprint, "rootfs")
-				if srcPath == "" {
-					continue
+				size, err := util.SafeCopy(target, patchedFile)
+				if err != nil {
+					return err
 				}
 
-				size, err := applyDelta(file, srcPath, req.RootfsFile)
-				if err != nil {
-					return nil, err
+				if req.VerifyAfterApply {
+					result := fmt.Sprintf("%x", patchedHash.Sum(nil))
+					if result != rootfs.Sha256 {
+						return fmt.Errorf("Patched rootfs hash mismatch (expected %q, got %q)", rootfs.Sha256, result)
+					}
 				}
 
 				parts := strings.Split(rootfs.Path, "/")
@@ -237,15 +341,40 @@ func (r *ProtocolSimpleStreams) GetImageFile(fingerprint string, req ImageFileRe
 
 		// Download the whole file
 		if !downloaded {
-			size, err := download(rootfs.Path, "rootfs", rootfs.Sha256, req.RootfsFile)
+			size, err := download(ctx, rootfs.Path, "rootfs", rootfs.Sha256, req.RootfsFile, req.ProgressHandler)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			parts := strings.Split(rootfs.Path, "/")
 			resp.RootfsName = parts[len(parts)-1]
 			resp.RootfsSize = size
 		}
+
+		return nil
+	}
+
+	if req.ParallelDownload {
+		// Download both files concurrently, a failure in one aborts the other.
+		group, ctx := errgroup.WithContext(ctx)
+		group.SetLimit(2)
+		group.Go(func() error { return downloadMeta(ctx) })
+		group.Go(func() error { return downloadRootfs(ctx) })
+
+		err = group.Wait()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = downloadMeta(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = downloadRootfs(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Validate the full image hash.
@@ -289,6 +418,113 @@ func (r *ProtocolSimpleStreams) GetImageFile(fingerprint string, req ImageFileRe
 	return &resp, nil
 }
 
+// maxDeltaChainLength is the maximum number of deltas applied in sequence to reach a target rootfs.
+const maxDeltaChainLength = 4
+
+// defaultDownloadRetries is how many times a failed file download is retried if the request doesn't say.
+const defaultDownloadRetries = 2
+
+// isTransientDownloadError returns whether a failed file download is worth retrying.
+// Only connection errors and server side errors are, not client errors or hash mismatches.
+func isTransientDownloadError(err error) bool {
+	if errors.Is(err, util.ErrServerError) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
+
+// deltaChainStep is a single delta to apply in a delta chain.
+type deltaChainStep struct {
+	source string
+	file   simplestreams.DownloadableFile
+}
+
+// resolveDeltaChain looks for the shortest sequence of deltas leading from a locally available
+// rootfs to the target image. It returns the path to the local rootfs and the deltas to apply
+// in order, or an empty chain if none could be found.
+func (r *ProtocolSimpleStreams) resolveDeltaChain(fingerprint string, files map[string]simplestreams.DownloadableFile, retriever func(fingerprint string, file string) string) (string, []deltaChainStep) {
+	type node struct {
+		files map[string]simplestreams.DownloadableFile
+		chain []deltaChainStep
+	}
+
+	visited := map[string]bool{fingerprint: true}
+	queue := []node{{files: files}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		// Sort the file names so that the selected chain is stable.
+		filenames := make([]string, 0, len(current.files))
+		for filename := range current.files {
+			filenames = append(filenames, filename)
+		}
+
+		slices.Sort(filenames)
+
+		for _, filename := range filenames {
+			_, srcFingerprint, prefixFound := strings.Cut(filename, "root.delta-")
+			if !prefixFound || visited[srcFingerprint] {
+				continue
+			}
+
+			visited[srcFingerprint] = true
+
+			chain := append([]deltaChainStep{{source: srcFingerprint, file: current.files[filename]}}, current.chain...)
+
+			// Check if we have the source file for the delta.
+			srcPath := retriever(srcFingerprint, "rootfs")
+			if srcPath != "" {
+				return srcPath, chain
+			}
+
+			if len(chain) >= maxDeltaChainLength {
+				continue
+			}
+
+			// Look for deltas leading to the intermediate image.
+			srcFiles, err := r.ssClient.GetFiles(srcFingerprint)
+			if err != nil {
+				continue
+			}
+
+			queue = append(queue, node{files: srcFiles, chain: chain})
+		}
+	}
+
+	return "", nil
+}
+
+// GetImageDeltas returns the fingerprints of the images the server offers rootfs deltas from for the given image.
+func (r *ProtocolSimpleStreams) GetImageDeltas(fingerprint string) ([]string, error) {
+	// Get the image and expand the fingerprint.
+	image, err := r.ssClient.GetImage(fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := r.ssClient.GetFiles(image.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []string{}
+	for filename := range files {
+		_, srcFingerprint, prefixFound := strings.Cut(filename, "root.delta-")
+		if prefixFound {
+			sources = append(sources, srcFingerprint)
+		}
+	}
+
+	slices.Sort(sources)
+
+	return sources, nil
+}
+
 // GetImageSecret isn't relevant for the simplestreams protocol.
 func (r *ProtocolSimpleStreams) GetImageSecret(_ string) (string, error) {
 	return "", errors.New("Private images aren't supported by the simplestreams protocol")
@@ -1,8 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -20,6 +26,10 @@ import (
 
 type cmdAdminRecover struct {
 	global *cmdGlobal
+
+	flagDryRun bool
+	flagFormat string
+	flagOnly   string
 }
 
 var cmdAdminRecoverUsage = u.Usage{u.RemoteColonOpt}
@@ -32,9 +42,16 @@ func (c *cmdAdminRecover) command() *cobra.Command {
 
   This command is mostly used for disaster recovery. It will ask you about unknown storage pools and attempt to
   access them, along with existing storage pools, and identify any missing instances and volumes that exist on the
-  pools but are not in the database. It will then offer to recreate these database records.`))
+  pools but are not in the database. It will then offer to recreate these database records.
+
+  With --dry-run, the scan results are reported (optionally as JSON with --format=json) and nothing is recovered.
+  The JSON report only covers the existing storage pools, with progress messages sent to stderr.`))
 	cmd.RunE = c.run
 
+	cli.AddBoolFlag(cmd.Flags(), &c.flagDryRun, "dry-run", i18n.G("Only scan for unknown volumes and report them, without recovering anything"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagFormat, "format|f", cli.TableFormatTable, "", i18n.G("Format of the --dry-run report (table|json)"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagOnly, "only", "", "", i18n.G("Only recover volumes of the given kind (custom|instances)"))
+
 	return cmd
 }
 
@@ -44,6 +61,32 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if !slices.Contains([]string{cli.TableFormatTable, cli.TableFormatJSON}, c.flagFormat) {
+		return fmt.Errorf(i18n.G("Invalid format: %s"), c.flagFormat)
+	}
+
+	if cmd.Flags().Changed("format") && !c.flagDryRun {
+		return errors.New(i18n.G("--format can only be used with --dry-run"))
+	}
+
+	// Keep stdout clean for the JSON report.
+	jsonOutput := c.flagFormat == cli.TableFormatJSON
+	out := io.Writer(os.Stdout)
+	if jsonOutput {
+		out = os.Stderr
+	}
+
+	importMode := recover.ImportModeAll
+	switch c.flagOnly {
+	case "":
+	case "custom":
+		importMode = recover.ImportModeVolumes
+	case "instances":
+		importMode = recover.ImportModeInstances
+	default:
+		return fmt.Errorf(i18n.G("Invalid value for --only: %q (must be custom or instances)"), c.flagOnly)
+	}
+
 	d := parsed[0].RemoteServer
 	server, _, err := d.GetServer()
 	if err != nil {
@@ -58,17 +101,17 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf(i18n.G("Failed getting existing storage pools: %w"), err)
 	}
 
-	fmt.Println(i18n.G("This server currently has the following storage pools:"))
+	fmt.Fprintln(out, i18n.G("This server currently has the following storage pools:"))
 	for _, existingPool := range existingPools {
-		fmt.Printf(" - "+i18n.G("%s (backend=%q, source=%q)")+"\n", existingPool.Name, existingPool.Driver, existingPool.Config["source"])
+		fmt.Fprintf(out, " - "+i18n.G("%s (backend=%q, source=%q)")+"\n", existingPool.Name, existingPool.Driver, existingPool.Config["source"])
 	}
 
 	unknownPools := make([]api.StoragePoolsPost, 0, len(existingPools))
 
 	// Build up a list of unknown pools to scan.
 	// We don't offer this option if the server is clustered because we don't allow creating storage pools on
-	// an individual server when clustered.
-	if !isClustered {
+	// an individual server when clustered. The prompts would also end up mixed with the JSON report.
+	if !isClustered && !jsonOutput {
 		var supportedDriverNames []string
 
 		for {
@@ -146,25 +189,28 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	fmt.Println(i18n.G("The recovery process will be scanning the following storage pools:"))
+	fmt.Fprintln(out, i18n.G("The recovery process will be scanning the following storage pools:"))
 	for _, p := range existingPools {
-		fmt.Printf(" - "+i18n.G("EXISTING: %q (backend=%q, source=%q)")+"\n", p.Name, p.Driver, p.Config["source"])
+		fmt.Fprintf(out, " - "+i18n.G("EXISTING: %q (backend=%q, source=%q)")+"\n", p.Name, p.Driver, p.Config["source"])
 	}
 
 	for _, p := range unknownPools {
-		fmt.Printf(" - "+i18n.G("NEW: %q (backend=%q, source=%q)")+"\n", p.Name, p.Driver, p.Config["source"])
+		fmt.Fprintf(out, " - "+i18n.G("NEW: %q (backend=%q, source=%q)")+"\n", p.Name, p.Driver, p.Config["source"])
 	}
 
-	proceed, err := c.global.asker.AskBool(i18n.G("Would you like to continue with scanning for lost volumes?")+" (yes/no) [default=yes]: ", "yes")
-	if err != nil {
-		return err
-	}
+	// A dry run doesn't change anything, so don't ask before scanning.
+	if !c.flagDryRun {
+		proceed, err := c.global.asker.AskBool(i18n.G("Would you like to continue with scanning for lost volumes?")+" (yes/no) [default=yes]: ", "yes")
+		if err != nil {
+			return err
+		}
 
-	if !proceed {
-		return nil
+		if !proceed {
+			return nil
+		}
 	}
 
-	fmt.Println(i18n.G("Scanning for unknown volumes..."))
+	fmt.Fprintln(out, i18n.G("Scanning for unknown volumes..."))
 
 	// Send /internal/recover/validate request to the daemon.
 	reqValidate := recover.ValidatePost{
@@ -181,6 +227,8 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 	// Add unknown pools to request.
 	reqValidate.Pools = append(reqValidate.Pools, unknownPools...)
 
+	var selectedVolumes []recover.ValidateVolume
+
 	for {
 		resp, _, err := d.RawQuery("POST", "/internal/recover/validate", reqValidate, "")
 		if err != nil {
@@ -194,6 +242,16 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf(i18n.G("Failed parsing validation response: %w"), err)
 		}
 
+		if c.flagDryRun {
+			if importMode != recover.ImportModeAll {
+				res.UnknownVolumes = slices.DeleteFunc(res.UnknownVolumes, func(vol recover.ValidateVolume) bool {
+					return !recoverVolumeMatchesMode(vol, importMode)
+				})
+			}
+
+			return c.renderDryRun(res)
+		}
+
 		if len(unknownPools) > 0 {
 			fmt.Println(i18n.G("The following unknown storage pools have been found:"))
 			for _, unknownPool := range unknownPools {
@@ -208,6 +266,39 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		if importMode != recover.ImportModeAll {
+			selectedVolumes = nil
+			for _, unknownVol := range res.UnknownVolumes {
+				if recoverVolumeMatchesMode(unknownVol, importMode) {
+					selectedVolumes = append(selectedVolumes, unknownVol)
+				}
+			}
+
+			if len(selectedVolumes) == 0 {
+				fmt.Printf(i18n.G("No unknown volumes match --only=%s. Nothing to do.")+"\n", c.flagOnly)
+				return nil
+			}
+
+			fmt.Printf(i18n.G("Only the following volumes will be recovered (--only=%s):")+"\n", c.flagOnly)
+			for _, unknownVol := range selectedVolumes {
+				fmt.Printf(" - "+i18n.G("%s %q on pool %q in project %q (includes %d snapshots)")+"\n", cases.Title(language.English).String(unknownVol.Type), unknownVol.Name, unknownVol.Pool, unknownVol.Project, unknownVol.SnapshotCount)
+			}
+		}
+
+		if len(res.UnsupportedPools) > 0 {
+			fmt.Println(i18n.G("The following storage pools can't be scanned for unknown volumes:"))
+			for _, unsupportedPool := range res.UnsupportedPools {
+				fmt.Printf(" - "+i18n.G("Storage pool %q of type %q")+"\n", unsupportedPool.Name, unsupportedPool.Driver)
+			}
+		}
+
+		if len(res.UnsupportedArchitectures) > 0 {
+			fmt.Println(i18n.G("The following instances can be recovered but can't run on this server:"))
+			for _, inst := range res.UnsupportedArchitectures {
+				fmt.Printf(" - "+i18n.G("Instance %q on pool %q in project %q (architecture %q)")+"\n", inst.Name, inst.Pool, inst.Project, inst.Architecture)
+			}
+		}
+
 		if len(res.DependencyErrors) == 0 {
 			if len(unknownPools) == 0 && len(res.UnknownVolumes) == 0 {
 				fmt.Println(i18n.G("No unknown storage pools or volumes found. Nothing to do."))
@@ -225,7 +316,12 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 		_, _ = c.global.asker.AskString(i18n.G("Please create those missing entries and then hit ENTER:")+" ", "", validate.Optional())
 	}
 
-	proceed, err = c.global.asker.AskBool(i18n.G("Would you like those to be recovered?")+" (yes/no) [default=no]: ", "no")
+	question := i18n.G("Would you like those to be recovered?")
+	if importMode != recover.ImportModeAll {
+		question = fmt.Sprintf(i18n.G("Would you like those %d volumes (%d snapshots) to be recovered? Other unknown volumes will be left untouched."), len(selectedVolumes), recoverSnapshotCount(selectedVolumes))
+	}
+
+	proceed, err := c.global.asker.AskBool(question+" (yes/no) [default=no]: ", "no")
 	if err != nil {
 		return err
 	}
@@ -241,12 +337,122 @@ func (c *cmdAdminRecover) run(cmd *cobra.Command, args []string) error {
 	// because their types are identical. This is less clear and will not work if either type changes in the future.
 	reqImport := recover.ImportPost{ //nolint:staticcheck
 		Pools: reqValidate.Pools,
+		Mode:  importMode,
 	}
 
-	_, _, err = d.RawQuery("POST", "/internal/recover/import", reqImport, "")
+	resp, _, err := d.RawQuery("POST", "/internal/recover/import", reqImport, "")
 	if err != nil {
 		return fmt.Errorf(i18n.G("Failed import request: %w"), err)
 	}
 
+	var res recover.ImportResult
+
+	err = resp.MetadataAsStruct(&res)
+	if err != nil {
+		return fmt.Errorf(i18n.G("Failed parsing import response: %w"), err)
+	}
+
+	if len(res.NeedsUpgrade) > 0 {
+		fmt.Println(i18n.G("The following recovered instances predate this server and may need attention:"))
+		for _, inst := range res.NeedsUpgrade {
+			fmt.Printf(" - "+i18n.G("Instance %q on pool %q in project %q:")+"\n", inst.Name, inst.Pool, inst.Project)
+			for _, reason := range inst.Reasons {
+				fmt.Printf("   - %s\n", reason)
+			}
+		}
+	}
+
 	return nil
 }
+
+// recoverVolumeMatchesMode returns whether the scanned volume would be imported with the given import mode.
+func recoverVolumeMatchesMode(vol recover.ValidateVolume, mode string) bool {
+	switch mode {
+	case recover.ImportModeVolumes:
+		return vol.Type == "volume"
+	case recover.ImportModeInstances:
+		return vol.Type != "volume" && vol.Type != "bucket"
+	case recover.ImportModeBuckets:
+		return vol.Type == "bucket"
+	}
+
+	return true
+}
+
+// recoverSnapshotCount returns the total number of snapshots of the given volumes.
+func recoverSnapshotCount(vols []recover.ValidateVolume) int {
+	count := 0
+	for _, vol := range vols {
+		count += vol.SnapshotCount
+	}
+
+	return count
+}
+
+// renderDryRun prints the unknown volumes and missing dependencies found by a validation scan.
+func (c *cmdAdminRecover) renderDryRun(res recover.ValidateResult) error {
+	if c.flagFormat == cli.TableFormatJSON {
+		data, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+
+		return nil
+	}
+
+	volumesData := [][]string{}
+	for _, vol := range res.UnknownVolumes {
+		volumesData = append(volumesData, []string{vol.Pool, vol.Project, vol.Type, vol.Name, strconv.Itoa(vol.SnapshotCount)})
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(volumesData))
+
+	fmt.Println(i18n.G("Unknown volumes:"))
+	err := cli.RenderTable(os.Stdout, c.flagFormat, []string{i18n.G("POOL"), i18n.G("PROJECT"), i18n.G("TYPE"), i18n.G("NAME"), i18n.G("SNAPSHOTS")}, volumesData, res.UnknownVolumes)
+	if err != nil {
+		return err
+	}
+
+	if len(res.UnsupportedPools) > 0 {
+		fmt.Println()
+		fmt.Println(i18n.G("Storage pools that can't be scanned:"))
+		for _, unsupportedPool := range res.UnsupportedPools {
+			fmt.Printf(" - "+i18n.G("Storage pool %q of type %q")+"\n", unsupportedPool.Name, unsupportedPool.Driver)
+		}
+	}
+
+	if len(res.UnsupportedArchitectures) > 0 {
+		fmt.Println()
+		fmt.Println(i18n.G("Instances with an architecture this server can't run:"))
+		for _, inst := range res.UnsupportedArchitectures {
+			fmt.Printf(" - "+i18n.G("Instance %q on pool %q in project %q (architecture %q)")+"\n", inst.Name, inst.Pool, inst.Project, inst.Architecture)
+		}
+	}
+
+	if len(res.DependencyErrors) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println(i18n.G("Missing dependencies:"))
+
+	// Older servers only report the dependency errors as strings.
+	if len(res.StructuredDependencyErrors) == 0 {
+		for _, depErr := range res.DependencyErrors {
+			fmt.Printf(" - %s\n", depErr)
+		}
+
+		return nil
+	}
+
+	depsData := [][]string{}
+	for _, depErr := range res.StructuredDependencyErrors {
+		depsData = append(depsData, []string{depErr.Kind, depErr.Name, depErr.Project})
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(depsData))
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, []string{i18n.G("KIND"), i18n.G("NAME"), i18n.G("PROJECT")}, depsData, res.StructuredDependencyErrors)
+}
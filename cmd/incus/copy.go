@@ -4,9 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net/http"
+	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v4"
 
 	incus "github.com/lxc/incus/v7/client"
 	"github.com/lxc/incus/v7/cmd/incus/color"
@@ -24,7 +29,9 @@ type cmdCopy struct {
 	flagNoProfiles          bool
 	flagProfile             []string
 	flagConfig              []string
+	flagConfigFromFile      string
 	flagDevice              []string
+	flagRemoveDevice        []string
 	flagEphemeral           bool
 	flagInstanceOnly        bool
 	flagMode                string
@@ -35,6 +42,9 @@ type cmdCopy struct {
 	flagRefresh             bool
 	flagRefreshExcludeOlder bool
 	flagAllowInconsistent   bool
+	flagTimeout             string
+	flagSnapshots           []string
+	flagShowOperation       bool
 }
 
 var cmdCopyUsage = u.Usage{u.MakePath(u.Instance, u.Snapshot.Optional()).Remote(), u.NewName(u.Instance).Optional().Remote()}
@@ -58,7 +68,9 @@ The pull transfer mode is the default as it is compatible with all server versio
 
 	cmd.RunE = c.run
 	cli.AddStringArrayFlag(cmd.Flags(), &c.flagConfig, "config|c", i18n.G("Config key/value to apply to the new instance"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagConfigFromFile, "config-from-file", "", "", i18n.G("File of config keys (YAML or key=value lines) to apply to the new instance"))
 	cli.AddStringArrayFlag(cmd.Flags(), &c.flagDevice, "device|d", i18n.G("New key/value to apply to a specific device"))
+	cli.AddStringArrayFlag(cmd.Flags(), &c.flagRemoveDevice, "remove-device", i18n.G("Device to leave out of the new instance (can be repeated)"))
 	cli.AddStringArrayFlag(cmd.Flags(), &c.flagProfile, "profile|p", i18n.G("Profile to apply to the new instance"))
 	cli.AddBoolFlag(cmd.Flags(), &c.flagEphemeral, "ephemeral|e", i18n.G("Ephemeral instance"))
 	cli.AddStringFlag(cmd.Flags(), &c.flagMode, "mode", "pull", "", i18n.G("Transfer mode. One of pull, push or relay"))
@@ -71,6 +83,9 @@ The pull transfer mode is the default as it is compatible with all server versio
 	cli.AddBoolFlag(cmd.Flags(), &c.flagRefresh, "refresh", i18n.G("Perform an incremental copy"))
 	cli.AddBoolFlag(cmd.Flags(), &c.flagRefreshExcludeOlder, "refresh-exclude-older", i18n.G("During incremental copy, exclude source snapshots earlier than latest target snapshot"))
 	cli.AddBoolFlag(cmd.Flags(), &c.flagAllowInconsistent, "allow-inconsistent", i18n.G("Ignore copy errors for volatile files"))
+	cli.AddStringArrayFlag(cmd.Flags(), &c.flagSnapshots, "snapshot", i18n.G("Only copy the specified snapshot (can be repeated)"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagTimeout, "timeout", "", "", i18n.G("Cancel the copy if it doesn't complete in time (e.g. 30m)"))
+	cli.AddBoolFlag(cmd.Flags(), &c.flagShowOperation, "show-operation", i18n.G("Print the server-side operation details if the copy fails"))
 
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
@@ -148,6 +163,26 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 		return errors.New(i18n.G("--no-profiles cannot be used with --refresh"))
 	}
 
+	// Parse the timeout, which covers both the copy and the follow-up refresh.
+	var deadline time.Time
+	if c.flagTimeout != "" {
+		timeout, err := time.ParseDuration(c.flagTimeout)
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf(i18n.G("Invalid timeout %q"), c.flagTimeout)
+		}
+
+		deadline = time.Now().Add(timeout)
+	}
+
+	remaining := func() time.Duration {
+		if deadline.IsZero() {
+			return 0
+		}
+
+		// Keep a minimal positive value so an expired deadline still cancels.
+		return max(time.Until(deadline), time.Millisecond)
+	}
+
 	// If the instance is being copied to a different remote and no destination name is
 	// specified, use the source name.
 	if !hasDstInstance {
@@ -168,8 +203,25 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 		return errors.New(i18n.G("To use --target, the destination remote must be a cluster"))
 	}
 
-	// Parse the config overrides
+	// Check the storage pool before starting a potentially long transfer.
+	if pool != "" {
+		err := checkCopyStoragePool(dstServer, pool)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Parse the config overrides, with --config taking precedence over --config-from-file.
 	configMap := map[string]string{}
+	if c.flagConfigFromFile != "" {
+		fileConfig, err := readConfigFile(c.flagConfigFromFile)
+		if err != nil {
+			return err
+		}
+
+		maps.Copy(configMap, fileConfig)
+	}
+
 	for _, entry := range c.flagConfig {
 		key, value, found := strings.Cut(entry, "=")
 		if !found {
@@ -184,6 +236,13 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 		return err
 	}
 
+	for _, name := range c.flagRemoveDevice {
+		_, ok := deviceMap[name]
+		if ok {
+			return fmt.Errorf(i18n.G("Device %q can't be both overridden and removed"), name)
+		}
+	}
+
 	var op incus.RemoteOperation
 	var writable api.InstancePut
 	var start bool
@@ -193,15 +252,29 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 			return errors.New(i18n.G("--instance-only can't be passed when the source is a snapshot"))
 		}
 
+		if len(c.flagSnapshots) > 0 {
+			return errors.New(i18n.G("--snapshot can't be passed when the source is a snapshot"))
+		}
+
 		// Prepare the instance creation request
 		args := incus.InstanceSnapshotCopyArgs{
-			Name: dstInstanceName,
-			Mode: mode,
-			Live: stateful,
+			Name:                dstInstanceName,
+			Mode:                mode,
+			Live:                stateful,
+			Refresh:             c.flagRefresh,
+			RefreshExcludeOlder: c.flagRefreshExcludeOlder,
 		}
 
+		// Refreshing from a snapshot requires an existing target.
 		if c.flagRefresh {
-			return errors.New(i18n.G("--refresh can only be used with instances"))
+			_, _, err := dstServer.GetInstance(dstInstanceName)
+			if err != nil {
+				if api.StatusErrorCheck(err, http.StatusNotFound) {
+					return fmt.Errorf(i18n.G("Target instance %q must exist to be refreshed from a snapshot"), dstInstanceName)
+				}
+
+				return err
+			}
 		}
 
 		// Copy of a snapshot into a new instance
@@ -236,6 +309,11 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 			maps.Copy(entry.Devices[k], m)
 		}
 
+		err = removeCopyDevices(entry.Devices, c.flagRemoveDevice)
+		if err != nil {
+			return err
+		}
+
 		// Allow overriding the ephemeral status
 		switch ephemeral {
 		case 1:
@@ -259,6 +337,10 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 			}
 		}
 
+		if srcServer != dst.RemoteServer {
+			c.warnPoolDrivers(srcServer, dstServer, entry.ExpandedDevices, entry.Devices, entry.Profiles)
+		}
+
 		// Do the actual copy
 		if c.flagTarget != "" {
 			dstServer = dstServer.UseTarget(c.flagTarget)
@@ -268,7 +350,43 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 		if err != nil {
 			return err
 		}
+
+		writable = api.InstancePut{
+			Architecture: entry.Architecture,
+			Config:       entry.Config,
+			Devices:      entry.Devices,
+			Ephemeral:    entry.Ephemeral,
+			Profiles:     entry.Profiles,
+			Description:  entry.Description,
+		}
 	} else {
+		if len(c.flagSnapshots) > 0 {
+			if instanceOnly {
+				return errors.New(i18n.G("--snapshot can't be used with --instance-only"))
+			}
+
+			if c.flagRefresh {
+				return errors.New(i18n.G("--snapshot can't be used with --refresh"))
+			}
+
+			// Make sure all the requested snapshots exist.
+			missing := []string{}
+			for _, snapName := range c.flagSnapshots {
+				_, _, err := srcServer.GetInstanceSnapshot(srcInstanceName, snapName)
+				if err != nil {
+					if !api.StatusErrorCheck(err, http.StatusNotFound) {
+						return err
+					}
+
+					missing = append(missing, snapName)
+				}
+			}
+
+			if len(missing) > 0 {
+				return fmt.Errorf(i18n.G("Snapshots not found on source instance: %s"), strings.Join(missing, ", "))
+			}
+		}
+
 		// Prepare the instance creation request
 		args := incus.InstanceCopyArgs{
 			Name:                dstInstanceName,
@@ -278,6 +396,7 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 			Refresh:             c.flagRefresh,
 			RefreshExcludeOlder: c.flagRefreshExcludeOlder,
 			AllowInconsistent:   c.flagAllowInconsistent,
+			Snapshots:           c.flagSnapshots,
 		}
 
 		// Copy of an instance into a new instance
@@ -318,6 +437,11 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 			maps.Copy(entry.Devices[k], m)
 		}
 
+		err = removeCopyDevices(entry.Devices, c.flagRemoveDevice)
+		if err != nil {
+			return err
+		}
+
 		// Allow overriding the ephemeral status
 		switch ephemeral {
 		case 1:
@@ -342,6 +466,10 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 			delete(entry.Config, "volatile.last_state.power")
 		}
 
+		if srcServer != dst.RemoteServer {
+			c.warnPoolDrivers(srcServer, dstServer, entry.ExpandedDevices, entry.Devices, entry.Profiles)
+		}
+
 		// Do the actual copy
 		if c.flagTarget != "" {
 			dstServer = dstServer.UseTarget(c.flagTarget)
@@ -368,9 +496,10 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 	}
 
 	// Wait for the copy to complete
-	err = cli.CancelableWait(op, &progress)
+	err = cli.CancelableWaitTimeout(op, &progress, remaining())
 	if err != nil {
 		progress.Done("")
+		c.showOperation(dstServer, op)
 		return err
 	}
 
@@ -412,9 +541,11 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 		}
 
 		// Wait for the copy to complete
-		err = cli.CancelableWait(op, &progress)
+		err = cli.CancelableWaitTimeout(op, &progress, remaining())
 		if err != nil {
 			progress.Done("")
+			target := op.Get()
+			c.showTargetOperation(dstServer, &target)
 			return err
 		}
 
@@ -441,6 +572,161 @@ func (c *cmdCopy) copyOrMove(cmd *cobra.Command, src *u.Parsed, dst *u.Parsed, k
 	return nil
 }
 
+// showOperation prints the target operation as last seen by the server, when requested.
+func (c *cmdCopy) showOperation(server incus.InstanceServer, op incus.RemoteOperation) {
+	if !c.flagShowOperation {
+		return
+	}
+
+	target, err := op.GetTarget()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, i18n.G("Failed to get the copy operation: %v")+"\n", err)
+		return
+	}
+
+	c.showTargetOperation(server, target)
+}
+
+// showTargetOperation prints the given operation as last seen by the server, when requested.
+func (c *cmdCopy) showTargetOperation(server incus.InstanceServer, target *api.Operation) {
+	if !c.flagShowOperation {
+		return
+	}
+
+	// Prefer the server's view over the locally tracked state.
+	current, _, err := server.GetOperation(target.ID)
+	if err == nil {
+		target = current
+	}
+
+	data, err := yaml.Dump(target, yaml.WithV2Defaults())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, i18n.G("Failed to render the copy operation: %v")+"\n", err)
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, i18n.G("Operation %s:")+"\n%s", target.ID, data)
+}
+
+// warnPoolDrivers warns when the source and target root disk pools use different drivers.
+func (c *cmdCopy) warnPoolDrivers(srcServer incus.InstanceServer, dstServer incus.InstanceServer, srcDevices map[string]map[string]string, dstDevices map[string]map[string]string, dstProfiles []string) {
+	if c.global.flagQuiet {
+		return
+	}
+
+	_, srcRootDisk, err := instance.GetRootDiskDevice(srcDevices)
+	if err != nil {
+		return
+	}
+
+	// Without a local root disk, the target pool comes from the last profile that has one.
+	_, dstRootDisk, err := instance.GetRootDiskDevice(dstDevices)
+	if err != nil {
+		for _, profileName := range dstProfiles {
+			profile, _, err := dstServer.GetProfile(profileName)
+			if err != nil {
+				return
+			}
+
+			_, profileRootDisk, err := instance.GetRootDiskDevice(profile.Devices)
+			if err == nil {
+				dstRootDisk = profileRootDisk
+			}
+		}
+	}
+
+	if srcRootDisk["pool"] == "" || dstRootDisk["pool"] == "" {
+		return
+	}
+
+	srcPool, _, err := srcServer.GetStoragePool(srcRootDisk["pool"])
+	if err != nil {
+		return
+	}
+
+	dstPool, _, err := dstServer.GetStoragePool(dstRootDisk["pool"])
+	if err != nil {
+		return
+	}
+
+	if srcPool.Driver != dstPool.Driver {
+		fmt.Fprintf(os.Stderr, i18n.G("Warning: Source pool %q uses %s but target pool %q uses %s, the transfer will use the generic method rather than an optimized one")+"\n", srcPool.Name, srcPool.Driver, dstPool.Name, dstPool.Driver)
+	}
+}
+
+// checkCopyStoragePool checks that the pool exists on the server and can hold instances.
+func checkCopyStoragePool(server incus.InstanceServer, poolName string) error {
+	names, err := server.GetStoragePoolNames()
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(names, poolName) {
+		slices.Sort(names)
+		return fmt.Errorf(i18n.G("Storage pool %q doesn't exist on the target server, available pools: %s"), poolName, strings.Join(names, ", "))
+	}
+
+	pool, _, err := server.GetStoragePool(poolName)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains([]string{"cephfs", "cephobject"}, pool.Driver) {
+		return fmt.Errorf(i18n.G("Storage pool %q uses the %s driver which can't hold instances"), poolName, pool.Driver)
+	}
+
+	if pool.Status != "" && pool.Status != api.StoragePoolStatusCreated {
+		return fmt.Errorf(i18n.G("Storage pool %q isn't usable (status: %s)"), poolName, pool.Status)
+	}
+
+	return nil
+}
+
+// readConfigFile reads config keys from a YAML map or from key=value lines.
+func readConfigFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]string{}
+	yamlErr := yaml.Load(content, &config)
+	if yamlErr == nil {
+		return config, nil
+	}
+
+	config = map[string]string{}
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf(i18n.G("Failed parsing %q, it is neither a YAML map (%v) nor key=value lines (line %d)"), path, yamlErr, i+1)
+		}
+
+		config[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return config, nil
+}
+
+// removeCopyDevices deletes the named devices, which must be local devices of the source instance.
+func removeCopyDevices(devices map[string]map[string]string, names []string) error {
+	for _, name := range names {
+		_, ok := devices[name]
+		if !ok {
+			return fmt.Errorf(i18n.G("Device %q doesn't exist on the source instance"), name)
+		}
+
+		delete(devices, name)
+	}
+
+	return nil
+}
+
 func (c *cmdCopy) run(cmd *cobra.Command, args []string) error {
 	parsed, err := c.global.Parse(cmdCopyUsage, cmd, args)
 	if err != nil {
@@ -459,9 +745,47 @@ func (c *cmdCopy) run(cmd *cobra.Command, args []string) error {
 		mode = c.flagMode
 	}
 
+	err = checkCopyMode(mode, parsed[0].RemoteServer, parsed[1].RemoteServer)
+	if err != nil {
+		return err
+	}
+
 	stateful := !c.flagStateless && !c.flagRefresh
 	keepVolatile := c.flagRefresh
 	instanceOnly := c.flagInstanceOnly
 
 	return c.copyOrMove(cmd, parsed[0], parsed[1], keepVolatile, ephem, stateful, instanceOnly, mode, c.flagStorage, false)
 }
+
+// checkCopyMode validates the transfer mode and, for pull and push, that the server being connected to listens on the network.
+func checkCopyMode(mode string, source incus.InstanceServer, target incus.InstanceServer) error {
+	if !slices.Contains([]string{"pull", "push", "relay"}, mode) {
+		return fmt.Errorf(i18n.G("Invalid transfer mode %q, must be one of pull, push or relay"), mode)
+	}
+
+	// Relayed data goes through the client and copies within a server don't use the network.
+	if mode == "relay" || source == target {
+		return nil
+	}
+
+	// In pull mode the target connects to the source, in push mode the other way around.
+	server := source
+	if mode == "push" {
+		server = target
+	}
+
+	info, _, err := server.GetServer()
+	if err != nil {
+		return err
+	}
+
+	if len(info.Environment.Addresses) > 0 {
+		return nil
+	}
+
+	if mode == "pull" {
+		return errors.New(i18n.G("The source server isn't listening on the network, use --mode=push or --mode=relay instead"))
+	}
+
+	return errors.New(i18n.G("The target server isn't listening on the network, use --mode=pull or --mode=relay instead"))
+}
@@ -1,8 +1,12 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,38 +14,53 @@ import (
 	"github.com/lxc/incus/v7/cmd/incus/color"
 	u "github.com/lxc/incus/v7/cmd/incus/usage"
 	"github.com/lxc/incus/v7/internal/i18n"
+	"github.com/lxc/incus/v7/shared/api"
 	cli "github.com/lxc/incus/v7/shared/cmd"
 	"github.com/lxc/incus/v7/shared/ioprogress"
 	"github.com/lxc/incus/v7/shared/logger"
 	"github.com/lxc/incus/v7/shared/units"
+	"github.com/lxc/incus/v7/shared/validate"
 )
 
 type cmdImport struct {
 	global *cmdGlobal
 
-	flagStorage string
-	flagConfig  []string
-	flagDevice  []string
+	flagStorage       string
+	flagConfig        []string
+	flagDevice        []string
+	flagTargetProject string
+	flagTarget        string
 }
 
-var cmdImportUsage = u.Usage{u.RemoteColonOpt, u.BackupFile, u.NewName(u.Instance).Optional()}
+var cmdImportUsage = u.Usage{u.RemoteColonOpt, u.BackupFile, u.Either(u.BackupFile.List(1), u.NewName(u.Instance)).Optional()}
 
 func (c *cmdImport) command() *cobra.Command {
 	cmd := &cobra.Command{}
 	cmd.Use = cli.U("import", cmdImportUsage...)
 	cmd.Short = i18n.G("Import instance backups")
 	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(
-		`Import backups of instances including their snapshots.`,
+		`Import backups of instances including their snapshots.
+
+Multiple backup files can be provided, in which case they are imported one
+after the other and the instance names are taken from the backups.`,
 	))
 	cmd.Example = cli.FormatSection("", i18n.G(
 		`incus import backup0.tar.gz
-    Create a new instance using backup0.tar.gz as the source.`,
+    Create a new instance using backup0.tar.gz as the source.
+
+incus import backup0.tar.gz backup1.tar.gz
+    Create two new instances using backup0.tar.gz and backup1.tar.gz as the sources.
+
+incus import backup0.tar.gz --target-project foo
+    Create a new instance in project foo using backup0.tar.gz as the source.`,
 	))
 
 	cmd.RunE = c.run
 	cli.AddStringFlag(cmd.Flags(), &c.flagStorage, "storage|s", "", "", i18n.G("Storage pool name"))
 	cli.AddStringArrayFlag(cmd.Flags(), &c.flagConfig, "config|c", i18n.G("Config key/value to apply to the new instance"))
 	cli.AddStringArrayFlag(cmd.Flags(), &c.flagDevice, "device|d", i18n.G("New key/value to apply to a specific device"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagTargetProject, "target-project", "", "", i18n.G("Import into a project different from the current one"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagTarget, "target", "", "", i18n.G("Cluster member name"))
 
 	return cmd
 }
@@ -53,9 +72,68 @@ func (c *cmdImport) run(cmd *cobra.Command, args []string) error {
 	}
 
 	d := parsed[0].RemoteServer
-	backupFile := parsed[1].String
-	instanceName := parsed[2].String
+	backupFiles := []string{parsed[1].String}
+	instanceName := ""
+
+	if !parsed[2].Skipped {
+		extra := parsed[2].StringList
+
+		// A single extra argument that is a valid instance name is the new instance name, anything else is a backup.
+		if len(extra) == 1 && validate.IsHostname(extra[0]) == nil {
+			instanceName = extra[0]
+		} else {
+			backupFiles = append(backupFiles, extra...)
+		}
+	}
+
+	// Place the instance on the requested cluster member.
+	if c.flagTarget != "" {
+		if !d.IsClustered() {
+			return errors.New(i18n.G("To use --target, the destination remote must be a cluster"))
+		}
+
+		d = d.UseTarget(c.flagTarget)
+	}
+
+	// Restore into the requested project, the server replaces the project recorded in the backup.
+	if c.flagTargetProject != "" {
+		_, _, err = d.GetProject(c.flagTargetProject)
+		if err != nil {
+			return fmt.Errorf(i18n.G("Failed loading target project %q: %w"), c.flagTargetProject, err)
+		}
+
+		d = d.UseProject(c.flagTargetProject)
+	}
+
+	if len(backupFiles) > 1 && slices.ContainsFunc(backupFiles, isStdin) {
+		return errors.New(i18n.G("Reading from stdin is only supported when importing a single backup"))
+	}
+
+	if len(backupFiles) == 1 {
+		return c.importBackup(d, backupFiles[0], instanceName, i18n.G("Importing instance: %s"))
+	}
 
+	failures := 0
+	for i, backupFile := range backupFiles {
+		format := fmt.Sprintf(i18n.G("Importing instance (%d/%d):"), i+1, len(backupFiles)) + " %s"
+
+		err := c.importBackup(d, backupFile, "", format)
+		if err != nil {
+			failures++
+			fmt.Fprintf(os.Stderr, i18n.G("Failed importing %q: %v")+"\n", backupFile, err)
+		}
+	}
+
+	if failures > 0 {
+		return fmt.Errorf(i18n.G("Failed importing %d of %d backups"), failures, len(backupFiles))
+	}
+
+	return nil
+}
+
+// importBackup creates a new instance from a single backup file.
+func (c *cmdImport) importBackup(d incus.InstanceServer, backupFile string, instanceName string, progressFormat string) error {
+	var err error
 	var file *os.File
 	if isStdin(backupFile) {
 		file = os.Stdin
@@ -75,7 +153,7 @@ func (c *cmdImport) run(cmd *cobra.Command, args []string) error {
 	}
 
 	progress := cli.ProgressRenderer{
-		Format: i18n.G("Importing instance: %s"),
+		Format: progressFormat,
 		Quiet:  c.global.flagQuiet,
 	}
 
@@ -85,7 +163,16 @@ func (c *cmdImport) run(cmd *cobra.Command, args []string) error {
 			Tracker: &ioprogress.ProgressTracker{
 				Length: fstat.Size(),
 				Handler: func(percent int64, speed int64) {
-					progress.UpdateProgress(ioprogress.ProgressData{Text: fmt.Sprintf("%d%% (%s/s)", percent, units.GetByteSizeString(speed, 2))})
+					text := fmt.Sprintf("%d%% (%s/s)", percent, units.GetByteSizeString(speed, 2))
+
+					// Only estimate the remaining time when the total size is known.
+					if fstat.Size() > 0 && speed > 0 {
+						remaining := fstat.Size() - fstat.Size()*percent/100
+						eta := time.Duration(remaining/speed) * time.Second
+						text = fmt.Sprintf("%d%% (%s/s, ETA %s)", percent, units.GetByteSizeString(speed, 2), eta)
+					}
+
+					progress.UpdateProgress(ioprogress.ProgressData{Text: text})
 				},
 			},
 		},
@@ -98,17 +185,26 @@ func (c *cmdImport) run(cmd *cobra.Command, args []string) error {
 	op, err := d.CreateInstanceFromBackup(createArgs)
 	if err != nil {
 		progress.Done("")
-		return err
+		return c.targetProjectError(err)
 	}
 
 	// Wait for operation to finish.
 	err = cli.CancelableWait(op, &progress)
 	if err != nil {
 		progress.Done("")
-		return err
+		return c.targetProjectError(err)
 	}
 
 	progress.Done("")
 
 	return nil
 }
+
+// targetProjectError mentions the target project in import errors rejected by the server, as they usually come from its configuration.
+func (c *cmdImport) targetProjectError(err error) error {
+	if c.flagTargetProject == "" || !api.StatusErrorCheck(err, http.StatusBadRequest, http.StatusForbidden) {
+		return err
+	}
+
+	return fmt.Errorf(i18n.G("Failed importing into project %q (check that its features and limits allow this instance): %w"), c.flagTargetProject, err)
+}
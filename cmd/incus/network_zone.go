@@ -1,18 +1,24 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"maps"
+	"net/http"
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/miekg/dns"
 	"github.com/spf13/cobra"
 	"go.yaml.in/yaml/v4"
 
+	incus "github.com/lxc/incus/v7/client"
 	"github.com/lxc/incus/v7/cmd/incus/color"
 	u "github.com/lxc/incus/v7/cmd/incus/usage"
 	"github.com/lxc/incus/v7/internal/i18n"
@@ -68,6 +74,14 @@ func (c *cmdNetworkZone) command() *cobra.Command {
 	networkZoneDeleteCmd := cmdNetworkZoneDelete{global: c.global, networkZone: c}
 	cmd.AddCommand(networkZoneDeleteCmd.command())
 
+	// Export.
+	networkZoneExportCmd := cmdNetworkZoneExport{global: c.global, networkZone: c}
+	cmd.AddCommand(networkZoneExportCmd.command())
+
+	// Import.
+	networkZoneImportCmd := cmdNetworkZoneImport{global: c.global, networkZone: c}
+	cmd.AddCommand(networkZoneImportCmd.command())
+
 	// Record.
 	networkZoneRecordCmd := cmdNetworkZoneRecord{global: c.global, networkZone: c}
 	cmd.AddCommand(networkZoneRecordCmd.command())
@@ -86,9 +100,10 @@ type cmdNetworkZoneList struct {
 	flagFormat      string
 	flagAllProjects bool
 	flagColumns     string
+	flagFilter      string
 }
 
-var cmdNetworkZoneListUsage = u.Usage{u.RemoteColonOpt}
+var cmdNetworkZoneListUsage = u.Usage{u.RemoteColonOpt, u.Placeholder(i18n.G("prefix")).Optional()}
 
 func (c *cmdNetworkZoneList) command() *cobra.Command {
 	cmd := &cobra.Command{}
@@ -98,6 +113,10 @@ func (c *cmdNetworkZoneList) command() *cobra.Command {
 	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(
 		`List available network zones
 
+An optional name prefix can be provided to only list matching zones.
+The --filter flag takes comma separated <key>=<value> pairs which are
+matched by the server, e.g. --filter description=foo.
+
 Default column layout: nDSdus
 
 == Columns ==
@@ -121,6 +140,7 @@ Pre-defined column shorthand chars:
 	cli.AddStringFlag(cmd.Flags(), &c.flagFormat, "format|f", c.global.defaultListFormat(), "", i18n.G(`Format (csv|json|table|yaml|compact|markdown), use suffix ",noheader" to disable headers and ",header" to enable it if missing, e.g. csv,header`))
 	cli.AddBoolFlag(cmd.Flags(), &c.flagAllProjects, "all-projects", i18n.G("Display network zones from all projects"))
 	cli.AddStringFlag(cmd.Flags(), &c.flagColumns, "columns|c", defaultNetworkZoneColumns, "", i18n.G("Columns"))
+	cli.AddStringFlag(cmd.Flags(), &c.flagFilter, "filter", "", "", i18n.G("Server side filters (<key>=<value>), comma separated"))
 
 	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
 		return cli.ValidateFlagFormatForListOutput(cmd.Flag("format").Value.String())
@@ -195,20 +215,33 @@ func (c *cmdNetworkZoneList) run(cmd *cobra.Command, args []string) error {
 	}
 
 	d := parsed[0].RemoteServer
+	prefix := parsed[1].Get("")
+
+	filters := []string{}
+	if c.flagFilter != "" {
+		filters = strings.Split(c.flagFilter, ",")
+	}
 
 	var zones []api.NetworkZone
 	if c.flagAllProjects {
-		zones, err = d.GetNetworkZonesAllProjects()
+		zones, err = d.GetNetworkZonesAllProjectsWithFilter(filters)
 		if err != nil {
 			return err
 		}
 	} else {
-		zones, err = d.GetNetworkZones()
+		zones, err = d.GetNetworkZonesWithFilter(filters)
 		if err != nil {
 			return err
 		}
 	}
 
+	// Filter by name prefix.
+	if prefix != "" {
+		zones = slices.DeleteFunc(zones, func(zone api.NetworkZone) bool {
+			return !strings.HasPrefix(zone.Name, prefix)
+		})
+	}
+
 	// Parse column flags.
 	columns, err := c.parseColumns()
 	if err != nil {
@@ -239,6 +272,8 @@ func (c *cmdNetworkZoneList) run(cmd *cobra.Command, args []string) error {
 type cmdNetworkZoneShow struct {
 	global      *cmdGlobal
 	networkZone *cmdNetworkZone
+
+	flagFormat string
 }
 
 var cmdNetworkZoneShowUsage = u.Usage{u.Zone.Remote()}
@@ -250,6 +285,8 @@ func (c *cmdNetworkZoneShow) command() *cobra.Command {
 	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G("Show network zone configurations"))
 	cmd.RunE = c.run
 
+	cli.AddStringFlag(cmd.Flags(), &c.flagFormat, "format|f", "yaml", "", i18n.G("Format (json|yaml)"))
+
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
 			return c.global.cmpNetworkZones(toComplete)
@@ -267,6 +304,11 @@ func (c *cmdNetworkZoneShow) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	err = validateNetworkZoneShowFormat(c.flagFormat)
+	if err != nil {
+		return err
+	}
+
 	d := parsed[0].RemoteServer
 	zoneName := parsed[0].RemoteObject.String
 
@@ -278,9 +320,36 @@ func (c *cmdNetworkZoneShow) run(cmd *cobra.Command, args []string) error {
 
 	sort.Strings(netZone.UsedBy)
 
-	data, err := yaml.Dump(&netZone, yaml.WithV2Defaults())
-	if err != nil {
-		return err
+	return renderNetworkZoneShow(c.flagFormat, netZone)
+}
+
+// validateNetworkZoneShowFormat checks that the format is supported by the show commands.
+func validateNetworkZoneShowFormat(format string) error {
+	if !slices.Contains([]string{"json", "yaml"}, format) {
+		return fmt.Errorf(i18n.G("Invalid format: %s"), format)
+	}
+
+	return nil
+}
+
+// renderNetworkZoneShow prints the given object in the requested format.
+func renderNetworkZoneShow(format string, obj any) error {
+	var data []byte
+	var err error
+
+	switch format {
+	case "json":
+		data, err = json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		data = append(data, '\n')
+	default:
+		data, err = yaml.Dump(obj, yaml.WithV2Defaults())
+		if err != nil {
+			return err
+		}
 	}
 
 	fmt.Printf("%s", data)
@@ -747,6 +816,191 @@ func (c *cmdNetworkZoneDelete) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// networkZoneExport is the document written by "network zone export" and read by "network zone import".
+type networkZoneExport struct {
+	api.NetworkZonePut `yaml:",inline"`
+
+	Name    string                       `json:"name" yaml:"name"`
+	Records []api.NetworkZoneRecordsPost `json:"records" yaml:"records"`
+}
+
+// Export.
+type cmdNetworkZoneExport struct {
+	global      *cmdGlobal
+	networkZone *cmdNetworkZone
+}
+
+var cmdNetworkZoneExportUsage = u.Usage{u.Zone.Remote(), u.Target(u.File).Optional()}
+
+func (c *cmdNetworkZoneExport) command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = cli.U("export", cmdNetworkZoneExportUsage...)
+	cmd.Short = i18n.G("Export network zones")
+	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(`Export network zones
+
+The zone configuration and all its records are written as a single YAML
+document, to standard output if no file is given.`))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network zone export example.net example.net.yaml
+    Export zone example.net and its records to example.net.yaml`))
+	cmd.RunE = c.run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkZones(toComplete)
+		}
+
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	return cmd
+}
+
+func (c *cmdNetworkZoneExport) run(cmd *cobra.Command, args []string) error {
+	parsed, err := c.global.Parse(cmdNetworkZoneExportUsage, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	d := parsed[0].RemoteServer
+	zoneName := parsed[0].RemoteObject.String
+
+	netZone, _, err := d.GetNetworkZone(zoneName)
+	if err != nil {
+		return err
+	}
+
+	records, err := d.GetNetworkZoneRecords(zoneName)
+	if err != nil {
+		return err
+	}
+
+	export := networkZoneExport{
+		NetworkZonePut: netZone.Writable(),
+		Name:           netZone.Name,
+		Records:        make([]api.NetworkZoneRecordsPost, 0, len(records)),
+	}
+
+	for _, record := range records {
+		export.Records = append(export.Records, api.NetworkZoneRecordsPost{
+			NetworkZoneRecordPut: record.Writable(),
+			Name:                 record.Name,
+		})
+	}
+
+	data, err := yaml.Dump(export, yaml.WithV2Defaults())
+	if err != nil {
+		return err
+	}
+
+	if parsed[1].Skipped {
+		fmt.Printf("%s", data)
+		return nil
+	}
+
+	return os.WriteFile(parsed[1].String, data, 0o644)
+}
+
+// Import.
+type cmdNetworkZoneImport struct {
+	global      *cmdGlobal
+	networkZone *cmdNetworkZone
+
+	flagForce bool
+}
+
+var cmdNetworkZoneImportUsage = u.Usage{u.RemoteColonOpt, u.File}
+
+func (c *cmdNetworkZoneImport) command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = cli.U("import", cmdNetworkZoneImportUsage...)
+	cmd.Short = i18n.G("Import network zones")
+	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(`Import network zones
+
+The file is a document produced by "incus network zone export".
+The zone and its records are created on the target server.
+If the zone already exists, --force updates its configuration and replaces
+the records found in the file.`))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network zone import other: example.net.yaml
+    Recreate the zone from example.net.yaml on remote "other"`))
+	cmd.RunE = c.run
+
+	cli.AddBoolFlag(cmd.Flags(), &c.flagForce, "force", i18n.G("Update the zone if it already exists"))
+
+	return cmd
+}
+
+func (c *cmdNetworkZoneImport) run(cmd *cobra.Command, args []string) error {
+	parsed, err := c.global.Parse(cmdNetworkZoneImportUsage, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	d := parsed[0].RemoteServer
+	fileName := parsed[1].String
+
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	var zone networkZoneExport
+	err = yaml.Load(contents, &zone, yaml.WithKnownFields())
+	if err != nil {
+		return fmt.Errorf(i18n.G("Failed parsing %q: %w"), fileName, err)
+	}
+
+	if zone.Name == "" {
+		return fmt.Errorf(i18n.G("Missing zone name in %q"), fileName)
+	}
+
+	if zone.Config == nil {
+		zone.Config = map[string]string{}
+	}
+
+	// Create the zone, or update it if it exists and --force was given.
+	_, etag, err := d.GetNetworkZone(zone.Name)
+	if err == nil {
+		if !c.flagForce {
+			return fmt.Errorf(i18n.G("Network zone %s already exists, use --force to update it"), zone.Name)
+		}
+
+		err = d.UpdateNetworkZone(zone.Name, zone.NetworkZonePut, etag)
+		if err != nil {
+			return err
+		}
+	} else if api.StatusErrorCheck(err, http.StatusNotFound) {
+		err = d.CreateNetworkZone(api.NetworkZonesPost{NetworkZonePut: zone.NetworkZonePut, Name: zone.Name})
+		if err != nil {
+			return err
+		}
+	} else {
+		return err
+	}
+
+	existing, err := d.GetNetworkZoneRecordNames(zone.Name)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range zone.Records {
+		if slices.Contains(existing, record.Name) {
+			err = d.UpdateNetworkZoneRecord(zone.Name, record.Name, record.NetworkZoneRecordPut, "")
+		} else {
+			err = d.CreateNetworkZoneRecord(zone.Name, record)
+		}
+
+		if err != nil {
+			return fmt.Errorf(i18n.G("Failed importing record %q: %w"), record.Name, err)
+		}
+	}
+
+	if !c.global.flagQuiet {
+		fmt.Printf(i18n.G("Network zone %s imported with %d records")+"\n", zone.Name, len(zone.Records))
+	}
+
+	return nil
+}
+
 // Add/Remove Rule.
 type cmdNetworkZoneRecord struct {
 	global      *cmdGlobal
@@ -806,7 +1060,8 @@ type cmdNetworkZoneRecordList struct {
 	global            *cmdGlobal
 	networkZoneRecord *cmdNetworkZoneRecord
 
-	flagFormat string
+	flagFormat   string
+	flagExpanded bool
 }
 
 var cmdNetworkZoneRecordListUsage = u.Usage{u.Zone.Remote()}
@@ -820,6 +1075,7 @@ func (c *cmdNetworkZoneRecordList) command() *cobra.Command {
 
 	cmd.RunE = c.run
 	cli.AddStringFlag(cmd.Flags(), &c.flagFormat, "format|f", c.global.defaultListFormat(), "", i18n.G(`Format (csv|json|table|yaml|compact|markdown), use suffix ",noheader" to disable headers and ",header" to enable it if missing, e.g. csv,header`))
+	cli.AddBoolFlag(cmd.Flags(), &c.flagExpanded, "expanded", i18n.G("Show one row per record entry"))
 
 	cmd.PreRunE = func(cmd *cobra.Command, _ []string) error {
 		return cli.ValidateFlagFormatForListOutput(cmd.Flag("format").Value.String())
@@ -851,6 +1107,10 @@ func (c *cmdNetworkZoneRecordList) run(cmd *cobra.Command, args []string) error
 		return err
 	}
 
+	if c.flagExpanded {
+		return c.renderExpanded(records)
+	}
+
 	data := [][]string{}
 	for _, record := range records {
 		entries := []string{}
@@ -879,10 +1139,38 @@ func (c *cmdNetworkZoneRecordList) run(cmd *cobra.Command, args []string) error
 	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, records)
 }
 
+// renderExpanded renders the records with one row per entry.
+func (c *cmdNetworkZoneRecordList) renderExpanded(records []api.NetworkZoneRecord) error {
+	data := [][]string{}
+	for _, record := range records {
+		for _, entry := range record.Entries {
+			ttl := ""
+			if entry.TTL > 0 {
+				ttl = strconv.FormatUint(entry.TTL, 10)
+			}
+
+			data = append(data, []string{record.Name, entry.Type, ttl, entry.Value})
+		}
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(data))
+
+	header := []string{
+		i18n.G("NAME"),
+		i18n.G("TYPE"),
+		i18n.G("TTL"),
+		i18n.G("VALUE"),
+	}
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, records)
+}
+
 // Show.
 type cmdNetworkZoneRecordShow struct {
 	global            *cmdGlobal
 	networkZoneRecord *cmdNetworkZoneRecord
+
+	flagFormat string
 }
 
 var cmdNetworkZoneRecordShowUsage = u.Usage{u.Zone.Remote(), u.Record}
@@ -894,6 +1182,8 @@ func (c *cmdNetworkZoneRecordShow) command() *cobra.Command {
 	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G("Show network zone record configurations"))
 	cmd.RunE = c.run
 
+	cli.AddStringFlag(cmd.Flags(), &c.flagFormat, "format|f", "yaml", "", i18n.G("Format (json|yaml)"))
+
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
 			return c.global.cmpNetworkZones(toComplete)
@@ -915,6 +1205,11 @@ func (c *cmdNetworkZoneRecordShow) run(cmd *cobra.Command, args []string) error
 		return err
 	}
 
+	err = validateNetworkZoneShowFormat(c.flagFormat)
+	if err != nil {
+		return err
+	}
+
 	d := parsed[0].RemoteServer
 	zoneName := parsed[0].RemoteObject.String
 	recordName := parsed[1].String
@@ -925,14 +1220,7 @@ func (c *cmdNetworkZoneRecordShow) run(cmd *cobra.Command, args []string) error
 		return err
 	}
 
-	data, err := yaml.Dump(&netRecord, yaml.WithV2Defaults())
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("%s", data)
-
-	return nil
+	return renderNetworkZoneShow(c.flagFormat, netRecord)
 }
 
 // Get.
@@ -1016,7 +1304,7 @@ type cmdNetworkZoneRecordCreate struct {
 	flagDescription string
 }
 
-var cmdNetworkZoneRecordCreateUsage = u.Usage{u.Zone.Remote(), u.NewName(u.Record), u.KV.List(0)}
+var cmdNetworkZoneRecordCreateUsage = u.Usage{u.Zone.Remote(), u.NewName(u.Record).Optional(u.KV.List(0))}
 
 func (c *cmdNetworkZoneRecordCreate) command() *cobra.Command {
 	cmd := &cobra.Command{}
@@ -1028,7 +1316,10 @@ func (c *cmdNetworkZoneRecordCreate) command() *cobra.Command {
     Create record r1 for zone z1
 
 incus network zone record create z1 r1 < config.yaml
-    Create record r1 for zone z1 with configuration from config.yaml`))
+    Create record r1 for zone z1 with configuration from config.yaml
+
+incus network zone record create z1 < records.yaml
+    Create all the records listed in records.yaml for zone z1`))
 
 	cmd.RunE = c.run
 
@@ -1057,22 +1348,48 @@ func (c *cmdNetworkZoneRecordCreate) run(cmd *cobra.Command, args []string) erro
 
 	d := parsed[0].RemoteServer
 	zoneName := parsed[0].RemoteObject.String
-	recordName := parsed[1].String
-	keys, err := kvToMap(parsed[2])
-	if err != nil {
-		return err
+	hasRecord := !parsed[1].Skipped
+	recordName := ""
+	keys := map[string]string{}
+
+	if hasRecord {
+		recordName = parsed[1].List[0].String
+		keys, err = kvToMap(parsed[1].List[1])
+		if err != nil {
+			return err
+		}
 	}
 
 	// If stdin isn't a terminal, read yaml from it.
-	var recordPut api.NetworkZoneRecordPut
+	var contents []byte
 	if !termios.IsTerminal(getStdinFd()) {
-		loader, err := yaml.NewLoader(os.Stdin, yaml.WithKnownFields())
+		contents, err = io.ReadAll(os.Stdin)
 		if err != nil {
 			return err
 		}
+	}
 
-		err = loader.Load(&recordPut)
-		if err != nil && !errors.Is(err, io.EOF) {
+	// A list of records creates all of them in one go.
+	var records []api.NetworkZoneRecordsPost
+	if len(contents) > 0 {
+		err = yaml.Load(contents, &records, yaml.WithKnownFields())
+		if err == nil && len(records) > 0 {
+			if hasRecord {
+				return errors.New(i18n.G("A record name can't be specified when creating a list of records"))
+			}
+
+			return c.createRecords(d, zoneName, records)
+		}
+	}
+
+	if !hasRecord {
+		return errors.New(i18n.G("Missing record name"))
+	}
+
+	var recordPut api.NetworkZoneRecordPut
+	if len(contents) > 0 {
+		err = yaml.Load(contents, &recordPut, yaml.WithKnownFields())
+		if err != nil {
 			return err
 		}
 	}
@@ -1105,6 +1422,34 @@ func (c *cmdNetworkZoneRecordCreate) run(cmd *cobra.Command, args []string) erro
 	return nil
 }
 
+// createRecords creates the given records in order, stopping at the first failure.
+func (c *cmdNetworkZoneRecordCreate) createRecords(d incus.InstanceServer, zoneName string, records []api.NetworkZoneRecordsPost) error {
+	for i, record := range records {
+		if record.Name == "" {
+			return fmt.Errorf(i18n.G("Record at index %d is missing a name"), i)
+		}
+
+		if record.Config == nil {
+			record.Config = map[string]string{}
+		}
+
+		if c.flagDescription != "" && record.Description == "" {
+			record.Description = c.flagDescription
+		}
+
+		err := d.CreateNetworkZoneRecord(zoneName, record)
+		if err != nil {
+			return fmt.Errorf(i18n.G("Failed to create record %q at index %d: %w"), record.Name, i, err)
+		}
+
+		if !c.global.flagQuiet {
+			fmt.Printf(i18n.G("Network zone record %s created")+"\n", record.Name)
+		}
+	}
+
+	return nil
+}
+
 // Set.
 type cmdNetworkZoneRecordSet struct {
 	global            *cmdGlobal
@@ -1436,6 +1781,12 @@ func (c *cmdNetworkZoneRecordEntry) command() *cobra.Command {
 	// Rule Remove.
 	cmd.AddCommand(c.commandRemove())
 
+	// Rule Set.
+	cmd.AddCommand(c.commandSet())
+
+	// Rule Import.
+	cmd.AddCommand(c.commandImport())
+
 	return cmd
 }
 
@@ -1477,19 +1828,48 @@ func (c *cmdNetworkZoneRecordEntry) runAdd(cmd *cobra.Command, args []string) er
 	entryType := parsed[2].String
 	entryValue := parsed[3].String
 
-	// Get the network record.
-	netRecord, etag, err := d.GetNetworkZoneRecord(zoneName, recordName)
-	if err != nil {
-		return err
-	}
+	return updateNetworkZoneRecordEntries(d, zoneName, recordName, func(entries []api.NetworkZoneRecordEntry) ([]api.NetworkZoneRecordEntry, error) {
+		// Check for an identical existing entry, which the server would reject.
+		for _, entry := range entries {
+			if entry.Type == entryType && entry.Value == entryValue {
+				return nil, fmt.Errorf(i18n.G("Entry %s %s already exists (TTL %d)"), entry.Type, entry.Value, entry.TTL)
+			}
+		}
 
-	// Add the entry.
-	netRecord.Entries = append(netRecord.Entries, api.NetworkZoneRecordEntry{
-		Type:  entryType,
-		TTL:   c.flagTTL,
-		Value: entryValue,
+		// Add the entry.
+		return append(entries, api.NetworkZoneRecordEntry{
+			Type:  entryType,
+			TTL:   c.flagTTL,
+			Value: entryValue,
+		}), nil
 	})
-	return d.UpdateNetworkZoneRecord(zoneName, recordName, netRecord.Writable(), etag)
+}
+
+// updateNetworkZoneRecordEntries applies a change to the entries of a record, retrying once if the record was modified concurrently.
+func updateNetworkZoneRecordEntries(d incus.InstanceServer, zoneName string, recordName string, apply func(entries []api.NetworkZoneRecordEntry) ([]api.NetworkZoneRecordEntry, error)) error {
+	var err error
+	for range 2 {
+		var netRecord *api.NetworkZoneRecord
+		var etag string
+
+		// Get the network zone record.
+		netRecord, etag, err = d.GetNetworkZoneRecord(zoneName, recordName)
+		if err != nil {
+			return err
+		}
+
+		netRecord.Entries, err = apply(netRecord.Entries)
+		if err != nil {
+			return err
+		}
+
+		err = d.UpdateNetworkZoneRecord(zoneName, recordName, netRecord.Writable(), etag)
+		if !api.StatusErrorCheck(err, http.StatusPreconditionFailed) {
+			return err
+		}
+	}
+
+	return fmt.Errorf(i18n.G("Network zone record %q was modified concurrently, please try again: %w"), recordName, err)
 }
 
 var cmdNetworkZoneRecordEntryRemoveUsage = u.Usage{u.Zone.Remote(), u.Record, u.Type, u.Value}
@@ -1529,6 +1909,60 @@ func (c *cmdNetworkZoneRecordEntry) runRemove(cmd *cobra.Command, args []string)
 	entryType := parsed[2].String
 	entryValue := parsed[3].String
 
+	return updateNetworkZoneRecordEntries(d, zoneName, recordName, func(entries []api.NetworkZoneRecordEntry) ([]api.NetworkZoneRecordEntry, error) {
+		for i, entry := range entries {
+			if entry.Type == entryType && entry.Value == entryValue {
+				return slices.Delete(entries, i, i+1), nil
+			}
+		}
+
+		return nil, errors.New(i18n.G("Couldn't find a matching entry"))
+	})
+}
+
+var cmdNetworkZoneRecordEntrySetUsage = u.Usage{u.Zone.Remote(), u.Record, u.Type, u.Value}
+
+func (c *cmdNetworkZoneRecordEntry) commandSet() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = cli.U("set", cmdNetworkZoneRecordEntrySetUsage...)
+	cmd.Short = i18n.G("Update a network zone record entry")
+	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G("Update the TTL of an existing network zone record entry"))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network zone record entry set example.net www A 192.0.2.10 --ttl 300
+    Set the TTL of the matching A entry to 300 seconds`))
+	cmd.RunE = c.runSet
+	cli.AddUint64Flag(cmd.Flags(), &c.flagTTL, "ttl", i18n.G("Entry TTL"))
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkZones(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkZoneRecords(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+func (c *cmdNetworkZoneRecordEntry) runSet(cmd *cobra.Command, args []string) error {
+	parsed, err := c.global.Parse(cmdNetworkZoneRecordEntrySetUsage, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Flags().Changed("ttl") {
+		return errors.New(i18n.G("The --ttl flag is required"))
+	}
+
+	d := parsed[0].RemoteServer
+	zoneName := parsed[0].RemoteObject.String
+	recordName := parsed[1].String
+	entryType := parsed[2].String
+	entryValue := parsed[3].String
+
 	// Get the network zone record.
 	netRecord, etag, err := d.GetNetworkZoneRecord(zoneName, recordName)
 	if err != nil {
@@ -1542,7 +1976,7 @@ func (c *cmdNetworkZoneRecordEntry) runRemove(cmd *cobra.Command, args []string)
 		}
 
 		found = true
-		netRecord.Entries = slices.Delete(netRecord.Entries, i, i+1)
+		netRecord.Entries[i].TTL = c.flagTTL
 		break
 	}
 
@@ -1552,3 +1986,111 @@ func (c *cmdNetworkZoneRecordEntry) runRemove(cmd *cobra.Command, args []string)
 
 	return d.UpdateNetworkZoneRecord(zoneName, recordName, netRecord.Writable(), etag)
 }
+
+var cmdNetworkZoneRecordEntryImportUsage = u.Usage{u.Zone.Remote(), u.Record, u.File}
+
+func (c *cmdNetworkZoneRecordEntry) commandImport() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = cli.U("import", cmdNetworkZoneRecordEntryImportUsage...)
+	cmd.Short = i18n.G("Import network zone record entries from a zone file")
+	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(`Import network zone record entries from a zone file
+
+The file holds one entry per line in BIND format, made of an optional
+TTL, an optional class, the record type and its value.
+Blank lines and ";" comments are ignored.
+The existing entries of the record are replaced.`))
+	cmd.Example = cli.FormatSection("", i18n.G(`incus network zone record entry import example.net www entries.zone
+    Replace the entries of record www with the ones from entries.zone`))
+	cmd.RunE = c.runImport
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpNetworkZones(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpNetworkZoneRecords(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	return cmd
+}
+
+func (c *cmdNetworkZoneRecordEntry) runImport(cmd *cobra.Command, args []string) error {
+	parsed, err := c.global.Parse(cmdNetworkZoneRecordEntryImportUsage, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	d := parsed[0].RemoteServer
+	zoneName := parsed[0].RemoteObject.String
+	recordName := parsed[1].String
+	fileName := parsed[2].String
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	// Parse all entries before touching the record.
+	entries, err := parseNetworkZoneRecordEntries(file)
+	if err != nil {
+		return fmt.Errorf(i18n.G("Failed parsing %q: %w"), fileName, err)
+	}
+
+	// Get the network zone record.
+	netRecord, etag, err := d.GetNetworkZoneRecord(zoneName, recordName)
+	if err != nil {
+		return err
+	}
+
+	netRecord.Entries = entries
+
+	return d.UpdateNetworkZoneRecord(zoneName, recordName, netRecord.Writable(), etag)
+}
+
+// parseNetworkZoneRecordEntries parses BIND style resource records without an owner name.
+func parseNetworkZoneRecordEntries(r io.Reader) ([]api.NetworkZoneRecordEntry, error) {
+	entries := []api.NetworkZoneRecordEntry{}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		// A zero default TTL leaves lines without one to the server default.
+		rr, err := dns.NewRR("$TTL 0\nrecord. " + line)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.G("Line %d: %w"), lineNum, err)
+		}
+
+		// Comment only lines that slipped through yield no record.
+		if rr == nil {
+			continue
+		}
+
+		entry := api.NetworkZoneRecordEntry{
+			Type:  dns.TypeToString[rr.Header().Rrtype],
+			TTL:   uint64(rr.Header().Ttl),
+			Value: strings.TrimPrefix(rr.String(), rr.Header().String()),
+		}
+
+		entries = append(entries, entry)
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
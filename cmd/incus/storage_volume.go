@@ -431,6 +431,10 @@ func (c *cmdStorageVolumeCopy) copyOrMove(cmd *cobra.Command, parsed []*u.Parsed
 	dstPoolName := parsed[1].RemoteObject.List[0].String
 	dstVolName := parsed[1].RemoteObject.List[1].String
 
+	if c.flagRefreshExcludeOlder && !c.flagRefresh {
+		return errors.New(i18n.G("--refresh-exclude-older can only be used with --refresh"))
+	}
+
 	// If the source server is standalone then --target cannot be provided.
 	if c.storage.flagTarget != "" && !srcServer.IsClustered() {
 		return errors.New(i18n.G("Cannot set --target when source server is not clustered"))
@@ -509,6 +513,7 @@ func (c *cmdStorageVolumeCopy) copyOrMove(cmd *cobra.Command, parsed []*u.Parsed
 		args.Mode = mode
 		args.VolumeOnly = false
 		args.Project = c.flagTargetProject
+		args.Refresh = c.flagRefresh
 
 		op, err = dstServer.MoveStoragePoolVolume(dstPoolName, srcServer, srcPoolName, *srcVol, args)
 		if err != nil {
@@ -1732,6 +1737,7 @@ func (c *cmdStorageVolumeMove) command() *cobra.Command {
 	cli.AddStringFlag(cmd.Flags(), &c.storage.flagTarget, "target", "", "", i18n.G("Cluster member name"))
 	cli.AddStringFlag(cmd.Flags(), &c.storageVolume.flagDestinationTarget, "destination-target", "", "", i18n.G("Destination cluster member name"))
 	cli.AddStringFlag(cmd.Flags(), &c.storageVolumeCopy.flagTargetProject, "target-project", "", "", i18n.G("Move to a project different from the source"))
+	cli.AddBoolFlag(cmd.Flags(), &c.storageVolumeCopy.flagRefresh, "refresh", i18n.G("Refresh an existing target volume instead of failing"))
 	cmd.RunE = c.run
 
 	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -47,6 +48,10 @@ func (c *cmdStorageVolumeSnapshot) command() *cobra.Command {
 	storageVolumeSnapshotListCmd := cmdStorageVolumeSnapshotList{global: c.global, storage: c.storage, storageVolume: c.storageVolume, storageVolumeSnapshot: c}
 	cmd.AddCommand(storageVolumeSnapshotListCmd.command())
 
+	// Prune
+	storageVolumeSnapshotPruneCmd := cmdStorageVolumeSnapshotPrune{global: c.global, storage: c.storage, storageVolume: c.storageVolume, storageVolumeSnapshot: c}
+	cmd.AddCommand(storageVolumeSnapshotPruneCmd.command())
+
 	// Rename
 	storageVolumeSnapshotRenameCmd := cmdStorageVolumeSnapshotRename{global: c.global, storage: c.storage, storageVolume: c.storageVolume, storageVolumeSnapshot: c}
 	cmd.AddCommand(storageVolumeSnapshotRenameCmd.command())
@@ -310,11 +315,12 @@ type cmdStorageVolumeSnapshotList struct {
 	flagFormat      string
 	flagColumns     string
 	flagAllProjects bool
+	flagAllVolumes  bool
 
 	defaultColumns string
 }
 
-var cmdStorageVolumeSnapshotListUsage = u.Usage{u.Pool.Remote(), u.MakePath(u.StorageVolumeType.Optional(), u.Volume)}
+var cmdStorageVolumeSnapshotListUsage = u.Usage{u.Pool.Remote(), u.MakePath(u.StorageVolumeType.Optional(), u.Volume).Optional()}
 
 func (c *cmdStorageVolumeSnapshotList) command() *cobra.Command {
 	cmd := &cobra.Command{}
@@ -326,6 +332,7 @@ func (c *cmdStorageVolumeSnapshotList) command() *cobra.Command {
 	c.defaultColumns = "nTE"
 	cli.AddStringFlag(cmd.Flags(), &c.flagColumns, "columns|c", c.defaultColumns, "", i18n.G("Columns"))
 	cli.AddBoolFlag(cmd.Flags(), &c.flagAllProjects, "all-projects", i18n.G("All projects"))
+	cli.AddBoolFlag(cmd.Flags(), &c.flagAllVolumes, "all-volumes", i18n.G("List the snapshots of all custom volumes in the pool"))
 	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(
 		`List storage volume snapshots
 
@@ -369,6 +376,19 @@ func (c *cmdStorageVolumeSnapshotList) run(cmd *cobra.Command, args []string) er
 
 	d := parsed[0].RemoteServer
 	poolName := parsed[0].RemoteObject.String
+
+	if c.flagAllVolumes {
+		if !parsed[1].Skipped {
+			return errors.New(i18n.G("A volume name can't be specified with --all-volumes"))
+		}
+
+		return c.listAllSnapshots(d, poolName)
+	}
+
+	if parsed[1].Skipped {
+		return errors.New(i18n.G("Missing storage volume name"))
+	}
+
 	volType := parsed[1].List[0].Get("custom")
 	volName := parsed[1].List[1].String
 
@@ -411,6 +431,71 @@ func (c *cmdStorageVolumeSnapshotList) listSnapshots(d incus.InstanceServer, poo
 	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, snapshots)
 }
 
+// storageVolumeSnapshotEntry is a snapshot along with the volume it belongs to.
+type storageVolumeSnapshotEntry struct {
+	api.StorageVolumeSnapshot `yaml:",inline"`
+
+	Volume  string `json:"volume" yaml:"volume"`
+	Project string `json:"project" yaml:"project"`
+}
+
+func (c *cmdStorageVolumeSnapshotList) listAllSnapshots(d incus.InstanceServer, poolName string) error {
+	var volumes []api.StorageVolumeFull
+	var err error
+
+	if c.flagAllProjects {
+		volumes, err = d.GetStoragePoolVolumesFullAllProjects(poolName)
+	} else {
+		volumes, err = d.GetStoragePoolVolumesFull(poolName)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Parse column flags.
+	columns, err := c.parseColumns()
+	if err != nil {
+		return err
+	}
+
+	entries := []storageVolumeSnapshotEntry{}
+	data := [][]string{}
+	for _, vol := range volumes {
+		if vol.Type != "custom" {
+			continue
+		}
+
+		for _, snap := range vol.Snapshots {
+			entries = append(entries, storageVolumeSnapshotEntry{StorageVolumeSnapshot: snap, Volume: vol.Name, Project: vol.Project})
+
+			line := []string{vol.Name}
+			if c.flagAllProjects {
+				line = append([]string{vol.Project}, line...)
+			}
+
+			for _, column := range columns {
+				line = append(line, column.Data(snap))
+			}
+
+			data = append(data, line)
+		}
+	}
+
+	sort.Sort(cli.SortColumnsNaturally(data))
+
+	header := []string{i18n.G("VOLUME")}
+	if c.flagAllProjects {
+		header = append([]string{i18n.G("PROJECT")}, header...)
+	}
+
+	for _, column := range columns {
+		header = append(header, column.Name)
+	}
+
+	return cli.RenderTable(os.Stdout, c.flagFormat, header, data, entries)
+}
+
 type storageVolumeSnapshotColumn struct {
 	Name string
 	Data func(api.StorageVolumeSnapshot) string
@@ -465,6 +550,85 @@ func (c *cmdStorageVolumeSnapshotList) expiresAtColumnData(snapshot api.StorageV
 	return snapshot.ExpiresAt.Local().Format(dateLayout)
 }
 
+// Snapshot prune.
+type cmdStorageVolumeSnapshotPrune struct {
+	global                *cmdGlobal
+	storage               *cmdStorage
+	storageVolume         *cmdStorageVolume
+	storageVolumeSnapshot *cmdStorageVolumeSnapshot
+}
+
+var cmdStorageVolumeSnapshotPruneUsage = u.Usage{u.Pool.Remote(), u.Volume}
+
+func (c *cmdStorageVolumeSnapshotPrune) command() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Use = cli.U("prune", cmdStorageVolumeSnapshotPruneUsage...)
+	cmd.Short = i18n.G("Delete expired storage volume snapshots")
+	cmd.Long = cli.FormatSection(color.DescriptionPrefix, i18n.G(`Delete expired storage volume snapshots
+
+Expired snapshots are otherwise only removed by the scheduled expiry task.`))
+
+	cli.AddStringFlag(cmd.Flags(), &c.storage.flagTarget, "target", "", "", i18n.G("Cluster member name"))
+	cmd.RunE = c.run
+
+	cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 0 {
+			return c.global.cmpStoragePools(toComplete)
+		}
+
+		if len(args) == 1 {
+			return c.global.cmpStoragePoolVolumes(args[0])
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+func (c *cmdStorageVolumeSnapshotPrune) run(cmd *cobra.Command, args []string) error {
+	parsed, err := c.global.Parse(cmdStorageVolumeSnapshotPruneUsage, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	d := parsed[0].RemoteServer
+	poolName := parsed[0].RemoteObject.String
+	volName := parsed[1].String
+
+	// If a target was specified, prune the volume on the given member.
+	if c.storage.flagTarget != "" {
+		d = d.UseTarget(c.storage.flagTarget)
+	}
+
+	// Prune the snapshots
+	op, err := d.PruneStoragePoolVolumeSnapshots(poolName, "custom", volName)
+	if err != nil {
+		return err
+	}
+
+	err = op.Wait()
+	if err != nil {
+		return err
+	}
+
+	if c.global.flagQuiet {
+		return nil
+	}
+
+	deleted, _ := op.Get().Metadata["deleted_snapshots"].([]any)
+	if len(deleted) == 0 {
+		fmt.Printf(i18n.G("No expired snapshots found for storage volume %s")+"\n", volName)
+		return nil
+	}
+
+	for _, name := range deleted {
+		fmt.Printf(i18n.G("Storage volume snapshot %s deleted from %s")+"\n", name, volName)
+	}
+
+	return nil
+}
+
 // Snapshot rename.
 type cmdStorageVolumeSnapshotRename struct {
 	global                *cmdGlobal
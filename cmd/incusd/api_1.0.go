@@ -126,6 +126,7 @@ var api10 = []APIEndpoint{
 	storagePoolBucketBackupCmd,
 	storagePoolBucketBackupsExportCmd,
 	storagePoolVolumesCmd,
+	storagePoolVolumesBulkDeleteCmd,
 	storagePoolVolumeSnapshotsTypeCmd,
 	storagePoolVolumeSnapshotTypeCmd,
 	storagePoolVolumesTypeCmd,
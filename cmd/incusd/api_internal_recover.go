@@ -6,9 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sys/unix"
 
 	internalInstance "github.com/lxc/incus/v7/internal/instance"
+	"github.com/lxc/incus/v7/internal/linux"
 	internalRecover "github.com/lxc/incus/v7/internal/recover"
 	"github.com/lxc/incus/v7/internal/server/auth"
 	"github.com/lxc/incus/v7/internal/server/backup"
@@ -27,6 +34,7 @@ import (
 	"github.com/lxc/incus/v7/shared/logger"
 	"github.com/lxc/incus/v7/shared/osarch"
 	"github.com/lxc/incus/v7/shared/revert"
+	"github.com/lxc/incus/v7/shared/util"
 )
 
 // Define API endpoints for recover actions.
@@ -48,7 +56,26 @@ func init() {
 }
 
 // internalRecoverScan provides the discovery and import functionality for both recovery validate and import steps.
-func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.StoragePoolsPost, validateOnly bool) response.Response {
+// When DryRun is set, the records an import would create are reported without changing anything.
+// When Selector is set, the whole pools are still scanned but only the matching volumes are imported.
+// The Mode controls which kinds of volumes are recovered.
+func internalRecoverScan(ctx context.Context, s *state.State, req internalRecover.ImportPost, validateOnly bool) response.Response {
+	userPools := req.Pools
+	dryRun := req.DryRun
+
+	for i := range userPools {
+		err := internalRecoverInferPool(&userPools[i])
+		if err != nil {
+			return response.BadRequest(err)
+		}
+	}
+
+	selector := req.Selector
+
+	importVolumes := req.Mode == internalRecover.ImportModeAll || req.Mode == internalRecover.ImportModeVolumes
+	importBuckets := req.Mode == internalRecover.ImportModeAll || req.Mode == internalRecover.ImportModeBuckets
+	importInstances := req.Mode == internalRecover.ImportModeAll || req.Mode == internalRecover.ImportModeInstances
+
 	var err error
 	var projects map[string]*api.Project
 	var projectProfiles map[string][]*api.Profile
@@ -123,11 +150,12 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 	defer reverter.Fail()
 
 	// addDependencyError adds an error to the list of dependency errors if not already present in list.
-	addDependencyError := func(err error) {
+	addDependencyError := func(err error, depErr internalRecover.ValidateDependencyError) {
 		errStr := err.Error()
 
 		if !slices.Contains(res.DependencyErrors, errStr) {
 			res.DependencyErrors = append(res.DependencyErrors, errStr)
+			res.StructuredDependencyErrors = append(res.StructuredDependencyErrors, depErr)
 		}
 	}
 
@@ -150,6 +178,24 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 		}
 	}()
 
+	// Check that the selected pool is one of the pools being recovered.
+	if selector != nil && selector.Pool != "" {
+		found := false
+		for _, p := range userPools {
+			if p.Name == selector.Pool {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return response.BadRequest(fmt.Errorf("Selected pool %q isn't part of the recovery request", selector.Pool))
+		}
+	}
+
+	// Used to track which of the selected volume names were found.
+	selectedVolumesFound := make(map[string]bool)
+
 	// Iterate the pools finding unknown volumes and perform validation.
 	for _, p := range userPools {
 		pool, err := storagePools.LoadByName(s, p.Name)
@@ -212,12 +258,23 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 		poolProjectVols, err := pool.ListUnknownVolumes(nil)
 		if err != nil {
 			if errors.Is(err, storageDrivers.ErrNotSupported) {
-				continue // Ignore unsupported storage drivers.
+				// Skip unsupported storage drivers but let the caller know about it.
+				res.UnsupportedPools = append(res.UnsupportedPools, internalRecover.ValidateUnsupportedPool{
+					Name:   pool.Name(),
+					Driver: pool.Driver().Info().Name,
+				})
+
+				continue
 			}
 
 			return response.SmartError(fmt.Errorf("Failed checking volumes on pool %q: %w", pool.Name(), err))
 		}
 
+		// Restrict the import to the selected volumes.
+		if selector != nil {
+			poolProjectVols = internalRecoverSelectVolumes(selector, p.Name, poolProjectVols, selectedVolumesFound)
+		}
+
 		// Store for consumption after validation scan to avoid needing to reprocess.
 		poolsProjectVols[p.Name] = poolProjectVols
 
@@ -231,7 +288,7 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 			var networkProjectName string
 
 			if projectInfo == nil {
-				addDependencyError(fmt.Errorf("Project %q", projectName))
+				addDependencyError(fmt.Errorf("Project %q", projectName), internalRecover.ValidateDependencyError{Kind: "project", Name: projectName})
 				continue // Skip further validation if project is missing.
 			}
 
@@ -239,8 +296,8 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 			networkProjectName = project.NetworkProjectFromRecord(projectInfo)
 
 			for _, poolVol := range poolVols {
-				if poolVol.Container == nil {
-					continue // Skip dependency checks for non-instance volumes.
+				if poolVol.Container == nil || !importInstances {
+					continue // Skip dependency checks for non-instance volumes or when not importing instances.
 				}
 
 				// Check that the instance's profile dependencies are met.
@@ -253,7 +310,7 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 					}
 
 					if !foundProfile {
-						addDependencyError(fmt.Errorf("Profile %q in project %q", poolInstProfileName, projectName))
+						addDependencyError(fmt.Errorf("Profile %q in project %q", poolInstProfileName, projectName), internalRecover.ValidateDependencyError{Kind: "profile", Name: poolInstProfileName, Project: projectName})
 					}
 				}
 
@@ -276,13 +333,39 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 					}
 
 					if !foundNetwork {
-						addDependencyError(fmt.Errorf("Network %q in project %q", devConfig["network"], projectName))
+						addDependencyError(fmt.Errorf("Network %q in project %q", devConfig["network"], projectName), internalRecover.ValidateDependencyError{Kind: "network", Name: devConfig["network"], Project: projectName})
 					}
 				}
 			}
 		}
 	}
 
+	// Report selectors that didn't match anything.
+	if selector != nil {
+		matched := false
+		for _, poolProjectVols := range poolsProjectVols {
+			if len(poolProjectVols) > 0 {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return response.BadRequest(errors.New("Recovery selector didn't match any unknown volume"))
+		}
+
+		var missing []string
+		for _, name := range selector.Volumes {
+			if !selectedVolumesFound[name] {
+				missing = append(missing, name)
+			}
+		}
+
+		if len(missing) > 0 {
+			return response.BadRequest(fmt.Errorf("Selected volumes not found: %s", strings.Join(missing, ", ")))
+		}
+	}
+
 	// If in validation mode or if there are dependency errors, return discovered unknown volumes, along with
 	// any dependency errors.
 	if validateOnly || len(res.DependencyErrors) > 0 {
@@ -297,6 +380,17 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 						displayType = poolVol.Container.Type
 						displayName = poolVol.Container.Name
 						displaySnapshotCount = len(poolVol.Snapshots)
+
+						// Flag instances that can be recovered but not run on this server.
+						arch, err := osarch.ArchitectureID(poolVol.Container.Architecture)
+						if err != nil || !slices.Contains(s.OS.Architectures, arch) {
+							res.UnsupportedArchitectures = append(res.UnsupportedArchitectures, internalRecover.ValidateUnsupportedArchitecture{
+								Name:         displayName,
+								Project:      projectName,
+								Pool:         poolName,
+								Architecture: poolVol.Container.Architecture,
+							})
+						}
 					} else if poolVol.Bucket != nil {
 						displayType = "bucket"
 						displayName = poolVol.Bucket.Name
@@ -322,9 +416,34 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 	}
 
 	// If in import mode and no dependency errors, then re-create missing DB records.
+	importRes := internalRecover.ImportResult{
+		DryRun:  dryRun,
+		Records: make(map[string][]internalRecover.ImportRecord),
+	}
+
+	// addImportRecord records a created DB record for the import result.
+	addImportRecord := func(recordType string, poolName string, projectName string, name string) {
+		importRes.Records[recordType] = append(importRes.Records[recordType], internalRecover.ImportRecord{
+			Pool:    poolName,
+			Project: projectName,
+			Name:    name,
+		})
+	}
+
+	// In dry-run mode, only report what would be recovered.
+	if dryRun {
+		internalRecoverDryRunRecords(pools, poolsProjectVols, selector, importVolumes, importBuckets, importInstances, &importRes)
+
+		return response.SyncResponse(true, &importRes)
+	}
 
 	// Create the pools themselves.
 	for _, pool := range pools {
+		// Don't touch pools other than the selected one.
+		if selector != nil && selector.Pool != "" && pool.Name() != selector.Pool {
+			continue
+		}
+
 		// Create missing storage pool DB record if needed.
 		if pool.ID() == storagePools.PoolIDTemporary {
 			var instPoolVol *backupConfig.Config // Instance volume used for new pool record.
@@ -368,6 +487,8 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 				_ = dbStoragePoolDeleteAndUpdateCache(context.Background(), s, pool.Name())
 			})
 
+			addImportRecord("pool", pool.Name(), "", pool.Name())
+
 			// Set storage pool node to storagePoolCreated.
 			// Must come before storage pool is loaded from the database.
 			err = s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
@@ -404,8 +525,8 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 			// Recover unknown custom volumes (do this first before recovering instances so that any
 			// instances that reference unknown custom volume disk devices can be created).
 			for _, poolVol := range poolVols {
-				if poolVol.Container != nil || poolVol.Bucket != nil {
-					continue // Skip instance volumes and buckets.
+				if poolVol.Container != nil || poolVol.Bucket != nil || !importVolumes {
+					continue // Skip instance volumes and buckets, or everything when not importing volumes.
 				} else if poolVol.Container == nil && poolVol.Volume == nil {
 					return response.SmartError(errors.New("Volume is neither instance nor custom volume"))
 				}
@@ -417,12 +538,17 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 				}
 
 				reverter.Add(cleanup)
+
+				addImportRecord("volume", pool.Name(), projectName, poolVol.Volume.Name)
+				for _, poolVolSnap := range poolVol.VolumeSnapshots {
+					addImportRecord("volume-snapshot", pool.Name(), projectName, poolVol.Volume.Name+internalInstance.SnapshotDelimiter+poolVolSnap.Name)
+				}
 			}
 
 			// Recover unknown buckets.
 			for _, poolVol := range poolVols {
-				// Skip non bucket volumes.
-				if poolVol.Bucket == nil {
+				// Skip non bucket volumes, or everything when not importing buckets.
+				if poolVol.Bucket == nil || !importBuckets {
 					continue
 				}
 
@@ -433,17 +559,30 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 				}
 
 				reverter.Add(cleanup)
+
+				addImportRecord("bucket", pool.Name(), projectName, poolVol.Bucket.Name)
 			}
 		}
 	}
 
-	// Finally restore the instances.
+	// Finally restore the instances, several at a time.
+	workers := req.Workers
+	if workers <= 0 {
+		workers = internalRecover.DefaultImportWorkers
+	}
+
+	var importMu sync.Mutex
+
+	group := new(errgroup.Group)
+	group.SetLimit(workers)
+
 	for _, pool := range pools {
 		for projectName, poolVols := range poolsProjectVols[pool.Name()] {
 			projectInfo := projects[projectName]
 
 			if projectInfo == nil {
 				// Shouldn't happen as we validated this above, but be sure for safety.
+				_ = group.Wait()
 				return response.SmartError(fmt.Errorf("Project %q not found", projectName))
 			}
 
@@ -451,69 +590,266 @@ func internalRecoverScan(ctx context.Context, s *state.State, userPools []api.St
 
 			// Recover unknown instance volumes.
 			for _, poolVol := range poolVols {
-				if poolVol.Container == nil && (poolVol.Volume != nil || poolVol.Bucket != nil) {
-					continue // Skip custom volumes, invalid volumes and buckets.
+				if (poolVol.Container == nil && (poolVol.Volume != nil || poolVol.Bucket != nil)) || !importInstances {
+					continue // Skip custom volumes, invalid volumes and buckets, or everything when not importing instances.
 				}
 
-				// Recover instance volumes and any snapshots.
-				profiles := make([]api.Profile, 0, len(poolVol.Container.Profiles))
-				for _, profileName := range poolVol.Container.Profiles {
-					for i := range projectProfiles[profileProjectName] {
-						if projectProfiles[profileProjectName][i].Name == profileName {
-							profiles = append(profiles, *projectProfiles[profileProjectName][i])
-						}
-					}
+				// Report instances whose config predates what this server expects.
+				reasons := internalRecoverInstanceUpgradeReasons(poolVol.Container)
+				if len(reasons) > 0 {
+					importRes.NeedsUpgrade = append(importRes.NeedsUpgrade, internalRecover.ImportUpgrade{
+						Name:    poolVol.Container.Name,
+						Project: projectName,
+						Pool:    pool.Name(),
+						Reasons: reasons,
+					})
 				}
 
-				inst, cleanup, err := internalRecoverImportInstance(s, pool, projectName, poolVol, profiles)
-				if err != nil {
-					return response.SmartError(fmt.Errorf("Failed creating instance %q record in project %q: %w", poolVol.Container.Name, projectName, err))
+				// Each instance gets its own reverter which is run as a whole by the main one on failure.
+				instReverter := revert.New()
+				reverter.Add(instReverter.Fail)
+
+				addRecord := func(recordType string, name string) {
+					importMu.Lock()
+					defer importMu.Unlock()
+
+					addImportRecord(recordType, pool.Name(), projectName, name)
 				}
 
-				reverter.Add(cleanup)
+				group.Go(func() error {
+					return internalRecoverScanImportInstance(s, pool, projectName, poolVol, projectProfiles[profileProjectName], instReverter, addRecord)
+				})
+			}
+		}
+	}
 
-				// Recover instance volume snapshots.
-				for _, poolInstSnap := range poolVol.Snapshots {
-					profiles := make([]api.Profile, 0, len(poolInstSnap.Profiles))
-					for _, profileName := range poolInstSnap.Profiles {
-						for i := range projectProfiles[profileProjectName] {
-							if projectProfiles[profileProjectName][i].Name == profileName {
-								profiles = append(profiles, *projectProfiles[profileProjectName][i])
-							}
-						}
-					}
+	err = group.Wait()
+	if err != nil {
+		return response.SmartError(err)
+	}
 
-					cleanup, err := internalRecoverImportInstanceSnapshot(s, pool, projectName, poolVol, poolInstSnap, profiles)
-					if err != nil {
-						return response.SmartError(fmt.Errorf("Failed creating instance %q snapshot %q record in project %q: %w", poolVol.Container.Name, poolInstSnap.Name, projectName, err))
-					}
+	reverter.Success()
 
-					reverter.Add(cleanup)
-				}
+	return response.SyncResponse(true, &importRes)
+}
 
-				// Recreate instance mount path and symlinks (must come after snapshot recovery).
-				cleanup, err = pool.ImportInstance(inst, poolVol, nil)
-				if err != nil {
-					return response.SmartError(fmt.Errorf("Failed importing instance %q in project %q: %w", poolVol.Container.Name, projectName, err))
+// internalRecoverInferPool fills in the name and driver of a pool given only by its source path.
+// This is limited to the dir and btrfs drivers, which need nothing more to mount and scan a pool.
+func internalRecoverInferPool(p *api.StoragePoolsPost) error {
+	source := p.Config["source"]
+	if source == "" || (p.Name != "" && p.Driver != "") {
+		return nil
+	}
+
+	if p.Driver == "" {
+		fsType, err := linux.DetectFilesystem(source)
+		if err != nil {
+			return fmt.Errorf("Failed detecting the filesystem of %q: %w", source, err)
+		}
+
+		// btrfs pools are subvolumes, which always have inode 256, while dir pools are plain directories.
+		p.Driver = "dir"
+		if fsType == "btrfs" {
+			var stat unix.Stat_t
+			err := unix.Stat(source, &stat)
+			if err != nil {
+				return fmt.Errorf("Failed to stat %q: %w", source, err)
+			}
+
+			if stat.Ino == 256 {
+				p.Driver = "btrfs"
+			}
+		}
+	}
+
+	if !slices.Contains([]string{"btrfs", "dir"}, p.Driver) {
+		return fmt.Errorf("Pool at %q needs its full configuration, only dir and btrfs pools can be given by source path alone", source)
+	}
+
+	if p.Name == "" {
+		p.Name = filepath.Base(filepath.Clean(source))
+	}
+
+	return nil
+}
+
+// internalRecoverInstanceUpgradeReasons returns why a recovered instance's config is older than this server expects.
+func internalRecoverInstanceUpgradeReasons(inst *api.Instance) []string {
+	var reasons []string
+
+	if inst.Config["volatile.uuid"] == "" {
+		reasons = append(reasons, "Missing volatile.uuid, a new instance UUID will be generated")
+	}
+
+	if inst.Type == string(api.InstanceTypeContainer) && util.IsFalseOrEmpty(inst.Config["security.privileged"]) && inst.Config["volatile.last_state.idmap"] == "" {
+		reasons = append(reasons, "Missing volatile.last_state.idmap, the root filesystem will be shifted on first start")
+	}
+
+	for key := range inst.Config {
+		if strings.HasPrefix(key, "volatile.") {
+			continue
+		}
+
+		_, err := internalInstance.ConfigKeyChecker(key, api.InstanceType(inst.Type))
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("Config key %q isn't supported by this server", key))
+		}
+	}
+
+	slices.Sort(reasons)
+
+	return reasons
+}
+
+// internalRecoverScanImportInstance recovers an instance along with its snapshots.
+// Revert hooks are added to instReverter and each created record is passed to addRecord.
+func internalRecoverScanImportInstance(s *state.State, pool storagePools.Pool, projectName string, poolVol *backupConfig.Config, projectProfiles []*api.Profile, instReverter *revert.Reverter, addRecord func(recordType string, name string)) error {
+	// Recover instance volumes and any snapshots.
+	profiles := make([]api.Profile, 0, len(poolVol.Container.Profiles))
+	for _, profileName := range poolVol.Container.Profiles {
+		for i := range projectProfiles {
+			if projectProfiles[i].Name == profileName {
+				profiles = append(profiles, *projectProfiles[i])
+			}
+		}
+	}
+
+	inst, cleanup, err := internalRecoverImportInstance(s, pool, projectName, poolVol, profiles)
+	if err != nil {
+		return fmt.Errorf("Failed creating instance %q record in project %q: %w", poolVol.Container.Name, projectName, err)
+	}
+
+	instReverter.Add(cleanup)
+
+	addRecord("instance", poolVol.Container.Name)
+
+	// Recover instance volume snapshots.
+	for _, poolInstSnap := range poolVol.Snapshots {
+		profiles := make([]api.Profile, 0, len(poolInstSnap.Profiles))
+		for _, profileName := range poolInstSnap.Profiles {
+			for i := range projectProfiles {
+				if projectProfiles[i].Name == profileName {
+					profiles = append(profiles, *projectProfiles[i])
 				}
+			}
+		}
 
-				reverter.Add(cleanup)
+		cleanup, err := internalRecoverImportInstanceSnapshot(s, pool, projectName, poolVol, poolInstSnap, profiles)
+		if err != nil {
+			return fmt.Errorf("Failed creating instance %q snapshot %q record in project %q: %w", poolVol.Container.Name, poolInstSnap.Name, projectName, err)
+		}
+
+		instReverter.Add(cleanup)
+
+		addRecord("instance-snapshot", poolVol.Container.Name+internalInstance.SnapshotDelimiter+poolInstSnap.Name)
+	}
+
+	// Recreate instance mount path and symlinks (must come after snapshot recovery).
+	cleanup, err = pool.ImportInstance(inst, poolVol, nil)
+	if err != nil {
+		return fmt.Errorf("Failed importing instance %q in project %q: %w", poolVol.Container.Name, projectName, err)
+	}
 
-				// Reinitialize the instance's root disk quota even if no size specified (allows the storage driver the
-				// opportunity to reinitialize the quota based on the new storage volume's DB ID).
-				_, rootConfig, err := internalInstance.GetRootDiskDevice(inst.ExpandedDevices().CloneNative())
-				if err == nil {
-					err = pool.SetInstanceQuota(inst, rootConfig["size"], rootConfig["size.state"], nil)
-					if err != nil {
-						return response.SmartError(fmt.Errorf("Failed reinitializing root disk quota %q for instance %q in project %q: %w", rootConfig["size"], poolVol.Container.Name, projectName, err))
+	instReverter.Add(cleanup)
+
+	// Reinitialize the instance's root disk quota even if no size specified (allows the storage driver the
+	// opportunity to reinitialize the quota based on the new storage volume's DB ID).
+	_, rootConfig, err := internalInstance.GetRootDiskDevice(inst.ExpandedDevices().CloneNative())
+	if err == nil {
+		err = pool.SetInstanceQuota(inst, rootConfig["size"], rootConfig["size.state"], nil)
+		if err != nil {
+			return fmt.Errorf("Failed reinitializing root disk quota %q for instance %q in project %q: %w", rootConfig["size"], poolVol.Container.Name, projectName, err)
+		}
+	}
+
+	return nil
+}
+
+// internalRecoverDryRunRecords fills the import result with the records an import would create, without creating them.
+func internalRecoverDryRunRecords(pools map[string]storagePools.Pool, poolsProjectVols map[string]map[string][]*backupConfig.Config, selector *internalRecover.ImportSelector, importVolumes bool, importBuckets bool, importInstances bool, importRes *internalRecover.ImportResult) {
+	addImportRecord := func(recordType string, poolName string, projectName string, name string) {
+		importRes.Records[recordType] = append(importRes.Records[recordType], internalRecover.ImportRecord{
+			Pool:    poolName,
+			Project: projectName,
+			Name:    name,
+		})
+	}
+
+	for _, pool := range pools {
+		if pool.ID() == storagePools.PoolIDTemporary && (selector == nil || selector.Pool == "" || pool.Name() == selector.Pool) {
+			addImportRecord("pool", pool.Name(), "", pool.Name())
+		}
+
+		for projectName, poolVols := range poolsProjectVols[pool.Name()] {
+			for _, poolVol := range poolVols {
+				if poolVol.Container != nil {
+					if !importInstances {
+						continue
+					}
+
+					reasons := internalRecoverInstanceUpgradeReasons(poolVol.Container)
+					if len(reasons) > 0 {
+						importRes.NeedsUpgrade = append(importRes.NeedsUpgrade, internalRecover.ImportUpgrade{
+							Name:    poolVol.Container.Name,
+							Project: projectName,
+							Pool:    pool.Name(),
+							Reasons: reasons,
+						})
+					}
+
+					addImportRecord("instance", pool.Name(), projectName, poolVol.Container.Name)
+					for _, poolInstSnap := range poolVol.Snapshots {
+						addImportRecord("instance-snapshot", pool.Name(), projectName, poolVol.Container.Name+internalInstance.SnapshotDelimiter+poolInstSnap.Name)
+					}
+				} else if poolVol.Bucket != nil {
+					if importBuckets {
+						addImportRecord("bucket", pool.Name(), projectName, poolVol.Bucket.Name)
+					}
+				} else if poolVol.Volume != nil && importVolumes {
+					addImportRecord("volume", pool.Name(), projectName, poolVol.Volume.Name)
+					for _, poolVolSnap := range poolVol.VolumeSnapshots {
+						addImportRecord("volume-snapshot", pool.Name(), projectName, poolVol.Volume.Name+internalInstance.SnapshotDelimiter+poolVolSnap.Name)
 					}
 				}
 			}
 		}
 	}
+}
 
-	reverter.Success()
-	return response.EmptySyncResponse
+// internalRecoverSelectVolumes returns the subset of the pool's unknown volumes matching the selector.
+// The names of the matched volumes are recorded in found.
+func internalRecoverSelectVolumes(selector *internalRecover.ImportSelector, poolName string, poolProjectVols map[string][]*backupConfig.Config, found map[string]bool) map[string][]*backupConfig.Config {
+	selected := make(map[string][]*backupConfig.Config)
+
+	if selector.Pool != "" && selector.Pool != poolName {
+		return selected
+	}
+
+	for projectName, poolVols := range poolProjectVols {
+		if selector.Project != "" && selector.Project != projectName {
+			continue
+		}
+
+		for _, poolVol := range poolVols {
+			var name string
+			if poolVol.Container != nil {
+				name = poolVol.Container.Name
+			} else if poolVol.Bucket != nil {
+				name = poolVol.Bucket.Name
+			} else if poolVol.Volume != nil {
+				name = poolVol.Volume.Name
+			}
+
+			if len(selector.Volumes) > 0 && !slices.Contains(selector.Volumes, name) {
+				continue
+			}
+
+			found[name] = true
+			selected[projectName] = append(selected[projectName], poolVol)
+		}
+	}
+
+	return selected
 }
 
 // internalRecoverImportInstance recreates the database records for an instance and returns the new instance.
@@ -543,7 +879,8 @@ func internalRecoverImportInstance(s *state.State, pool storagePools.Pool, proje
 		return nil, nil, errors.New("Invalid instance type")
 	}
 
-	inst, instOp, cleanup, err := instance.CreateInternal(s, *dbInst, nil, false, true, true)
+	// Don't check the architecture so that instances this server can't run can still be recovered.
+	inst, instOp, cleanup, err := instance.CreateInternal(s, *dbInst, nil, false, false, true)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Failed creating instance record: %w", err)
 	}
@@ -614,7 +951,7 @@ func internalRecoverValidate(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(err)
 	}
 
-	return internalRecoverScan(r.Context(), d.State(), req.Pools, true)
+	return internalRecoverScan(r.Context(), d.State(), internalRecover.ImportPost{Pools: req.Pools, Mode: internalRecover.ImportModeAll}, true)
 }
 
 // internalRecoverImport performs the pool volume recovery.
@@ -626,5 +963,14 @@ func internalRecoverImport(d *Daemon, r *http.Request) response.Response {
 		return response.BadRequest(err)
 	}
 
-	return internalRecoverScan(r.Context(), d.State(), req.Pools, false)
+	// Default to importing everything.
+	if req.Mode == "" {
+		req.Mode = internalRecover.ImportModeAll
+	}
+
+	if !slices.Contains([]string{internalRecover.ImportModeAll, internalRecover.ImportModeBuckets, internalRecover.ImportModeInstances, internalRecover.ImportModeVolumes}, req.Mode) {
+		return response.BadRequest(fmt.Errorf("Invalid recovery mode %q", req.Mode))
+	}
+
+	return internalRecoverScan(r.Context(), d.State(), *req, false)
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -321,6 +322,29 @@ type instanceCreateAsCopyOpts struct {
 	refreshExcludeOlder  bool              // During refresh, exclude source snapshots earlier than latest target snapshot
 	applyTemplateTrigger bool              // Apply deferred TemplateTriggerCopy.
 	allowInconsistent    bool              // Ignore some copy errors
+	snapshots            []string          // Only copy the listed snapshots (all if empty).
+}
+
+// instanceCheckSnapshotSelection checks that all the named snapshots exist on the instance.
+func instanceCheckSnapshotSelection(inst instance.Instance, names []string) error {
+	snaps, err := inst.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	snapNames := make([]string, 0, len(snaps))
+	for _, snap := range snaps {
+		_, snapName, _ := api.GetParentAndSnapshotName(snap.Name())
+		snapNames = append(snapNames, snapName)
+	}
+
+	for _, name := range names {
+		if !slices.Contains(snapNames, name) {
+			return api.StatusErrorf(http.StatusBadRequest, "Snapshot %q not found on source instance", name)
+		}
+	}
+
+	return nil
 }
 
 // instanceCreateAsCopy create a new instance by copying from an existing instance.
@@ -368,7 +392,8 @@ func instanceCreateAsCopy(s *state.State, opts instanceCreateAsCopyOpts, op *ope
 
 	var snapshots []instance.Instance
 
-	if !opts.instanceOnly {
+	// Snapshot sources carry no snapshots of their own, so leave the target's snapshots alone.
+	if !opts.instanceOnly && !opts.sourceInstance.IsSnapshot() {
 		if opts.refresh {
 			// Compare snapshots.
 			sourceSnaps, err := opts.sourceInstance.Snapshots()
@@ -422,6 +447,14 @@ func instanceCreateAsCopy(s *state.State, opts instanceCreateAsCopyOpts, op *ope
 			if err != nil {
 				return nil, err
 			}
+
+			// Restrict to the selected snapshots.
+			if len(opts.snapshots) > 0 {
+				snapshots = slices.DeleteFunc(snapshots, func(snap instance.Instance) bool {
+					_, snapName, _ := api.GetParentAndSnapshotName(snap.Name())
+					return !slices.Contains(opts.snapshots, snapName)
+				})
+			}
 		}
 
 		var snapInstOps []*operationlock.InstanceOperation
@@ -508,7 +541,7 @@ func instanceCreateAsCopy(s *state.State, opts instanceCreateAsCopyOpts, op *ope
 			return nil, fmt.Errorf("Refresh instance: %w", err)
 		}
 	} else {
-		err = pool.CreateInstanceFromCopy(inst, opts.sourceInstance, !opts.instanceOnly, opts.allowInconsistent, op)
+		err = pool.CreateInstanceFromCopy(inst, opts.sourceInstance, !opts.instanceOnly, opts.snapshots, opts.allowInconsistent, op)
 		if err != nil {
 			return nil, fmt.Errorf("Create instance from copy: %w", err)
 		}
@@ -578,7 +611,7 @@ func autoCreateInstanceSnapshots(ctx context.Context, s *state.State, instances
 			return err
 		}
 
-		expiry, err := internalInstance.GetExpiry(time.Now(), inst.ExpandedConfig()["snapshots.expiry"])
+		expiry, err := internalInstance.GetExpiry(time.Now(), snapshotExpiryDuration(inst.ExpandedConfig(), false))
 		if err != nil {
 			l.Error("Error getting snapshots.expiry date")
 			return err
@@ -507,7 +507,18 @@ func instancePost(d *Daemon, r *http.Request) response.Response {
 	}
 
 	// Cross-server instance migration.
-	ws, err := newMigrationSource(inst, req.Live, req.InstanceOnly, req.AllowInconsistent, "", "", req.Devices, req.Target)
+	if len(req.Snapshots) > 0 {
+		if req.InstanceOnly {
+			return response.BadRequest(errors.New("Snapshot selection can't be used with instance only migration"))
+		}
+
+		err = instanceCheckSnapshotSelection(inst, req.Snapshots)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	ws, err := newMigrationSource(inst, req.Live, req.InstanceOnly, req.AllowInconsistent, "", "", req.Devices, req.Snapshots, req.Target)
 	if err != nil {
 		return response.InternalError(err)
 	}
@@ -892,7 +903,7 @@ func migrateInstance(ctx context.Context, s *state.State, inst instance.Instance
 		}
 
 		// Setup a new migration source.
-		sourceMigration, err := newMigrationSource(inst, req.Live, false, req.AllowInconsistent, inst.Name(), req.Pool, req.Devices, nil)
+		sourceMigration, err := newMigrationSource(inst, req.Live, false, req.AllowInconsistent, inst.Name(), req.Pool, req.Devices, nil, nil)
 		if err != nil {
 			return fmt.Errorf("Failed setting up instance migration on source: %w", err)
 		}
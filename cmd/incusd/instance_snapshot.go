@@ -325,12 +325,7 @@ func instanceSnapshotsPost(d *Daemon, r *http.Request) response.Response {
 	if req.ExpiresAt != nil {
 		expiry = *req.ExpiresAt
 	} else {
-		duration := inst.ExpandedConfig()["snapshots.expiry.manual"]
-		if duration == "" {
-			duration = inst.ExpandedConfig()["snapshots.expiry"]
-		}
-
-		expiry, err = internalInstance.GetExpiry(time.Now(), duration)
+		expiry, err = internalInstance.GetExpiry(time.Now(), snapshotExpiryDuration(inst.ExpandedConfig(), true))
 		if err != nil {
 			return response.BadRequest(err)
 		}
@@ -715,7 +710,7 @@ func snapshotPost(s *state.State, r *http.Request, snapInst instance.Instance) r
 			}
 		}
 
-		ws, err := newMigrationSource(snapInst, reqNew.Live, true, false, "", "", nil, req.Target)
+		ws, err := newMigrationSource(snapInst, reqNew.Live, true, false, "", "", nil, nil, req.Target)
 		if err != nil {
 			return response.SmartError(err)
 		}
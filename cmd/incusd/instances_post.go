@@ -628,6 +628,18 @@ func createFromCopy(ctx context.Context, s *state.State, r *http.Request, projec
 		return response.SmartError(err)
 	}
 
+	// Validate the snapshot selection.
+	if len(req.Source.Snapshots) > 0 {
+		if req.Source.InstanceOnly || source.IsSnapshot() {
+			return response.BadRequest(errors.New("Snapshot selection can only be used when copying an instance with its snapshots"))
+		}
+
+		err = instanceCheckSnapshotSelection(source, req.Source.Snapshots)
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
 	// When clustered, use the node name, otherwise use the hostname.
 	if s.ServerClustered {
 		serverName := s.ServerName
@@ -752,6 +764,7 @@ func createFromCopy(ctx context.Context, s *state.State, r *http.Request, projec
 			refreshExcludeOlder:  req.Source.RefreshExcludeOlder,
 			applyTemplateTrigger: true,
 			allowInconsistent:    req.Source.AllowInconsistent,
+			snapshots:            req.Source.Snapshots,
 		}, op)
 		if err != nil {
 			return err
@@ -1662,6 +1675,7 @@ func clusterCopyContainerInternal(ctx context.Context, s *state.State, r *http.R
 			Live:         req.Source.Live,
 			InstanceOnly: instanceOnly,
 			Devices:      req.Devices,
+			Snapshots:    req.Source.Snapshots,
 		}
 
 		op, err := client.MigrateInstance(req.Source.Source, pullReq)
@@ -1690,6 +1704,7 @@ func clusterCopyContainerInternal(ctx context.Context, s *state.State, r *http.R
 	req.Source.Websockets = websockets
 	req.Source.Source = ""
 	req.Source.Project = ""
+	req.Source.Snapshots = nil
 
 	// Run the migration
 	return createFromMigration(ctx, s, nil, projectName, profiles, req)
@@ -25,6 +25,9 @@ import (
 	"github.com/lxc/incus/v7/shared/logger"
 )
 
+// migrationChecksumPrefix marks a control message carrying the checksum of the transferred data.
+const migrationChecksumPrefix = "sha256:"
+
 type migrationFields struct {
 	controlLock sync.Mutex
 
@@ -39,6 +42,8 @@ type migrationFields struct {
 	volumeOnly        bool
 	allowInconsistent bool
 	storagePool       string
+	transferLimit     int64
+	verifyTransfer    bool
 }
 
 func (c *migrationFields) send(m proto.Message) error {
@@ -171,6 +176,7 @@ type migrationSourceWs struct {
 
 	clusterMoveSourceName string
 	devices               api.DevicesMap
+	snapshotNames         []string
 
 	pushCertificate  string
 	pushOperationURL string
@@ -218,6 +224,7 @@ type migrationSink struct {
 	clusterMoveSourceName string
 	refresh               bool
 	refreshExcludeOlder   bool
+	resume                bool
 }
 
 // MigrationSinkArgs arguments to configure migration sink.
@@ -239,9 +246,12 @@ type migrationSinkArgs struct {
 	Snapshots             []*migration.Snapshot
 
 	// Storage specific fields
-	StoragePool string
-	VolumeOnly  bool
-	VolumeSize  int64
+	StoragePool    string
+	VolumeOnly     bool
+	VolumeSize     int64
+	TransferLimit  int64
+	VerifyTransfer bool
+	Resume         bool
 
 	// Transport specific fields
 	RsyncFeatures []string
@@ -2,24 +2,33 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
+	"net/http"
 	"net/url"
 	"slices"
 	"strings"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
+	internalIO "github.com/lxc/incus/v7/internal/io"
 	"github.com/lxc/incus/v7/internal/migration"
 	localMigration "github.com/lxc/incus/v7/internal/server/migration"
 	"github.com/lxc/incus/v7/internal/server/operations"
 	"github.com/lxc/incus/v7/internal/server/project"
+	"github.com/lxc/incus/v7/internal/server/response"
 	"github.com/lxc/incus/v7/internal/server/state"
 	storagePools "github.com/lxc/incus/v7/internal/server/storage"
 	storageDrivers "github.com/lxc/incus/v7/internal/server/storage/drivers"
 	internalUtil "github.com/lxc/incus/v7/internal/util"
 	"github.com/lxc/incus/v7/shared/api"
 	"github.com/lxc/incus/v7/shared/logger"
+	"github.com/lxc/incus/v7/shared/util"
 )
 
 func newStorageMigrationSource(volumeOnly bool, pushTarget *api.StorageVolumePostTarget) (*migrationSourceWs, error) {
@@ -150,6 +159,7 @@ func (s *migrationSourceWs) DoStorage(st *state.State, projectName string, poolN
 	indexHeaderVersion := localMigration.IndexHeaderVersion
 	offerHeader.IndexHeaderVersion = &indexHeaderVersion
 	offerHeader.VolumeSize = &volSize
+	offerHeader.VerifyTransfer = &s.verifyTransfer
 
 	// Only send snapshots when requested.
 	if !s.volumeOnly {
@@ -225,12 +235,31 @@ func (s *migrationSourceWs) DoStorage(st *state.State, projectName string, poolN
 		return err
 	}
 
+	fsConn = internalIO.NewRateLimitedReadWriteCloser(fsConn, s.transferLimit)
+
+	// Hash everything sent so the target can verify it received the same data.
+	var transferHash hash.Hash
+	if respHeader.GetVerifyTransfer() {
+		transferHash = sha256.New()
+		fsConn = internalIO.NewHashReadWriteCloser(fsConn, nil, transferHash)
+	}
+
 	err = pool.MigrateCustomVolume(projectName, fsConn, volSourceArgs, migrateOp)
 	if err != nil {
 		s.sendControl(err)
 		return err
 	}
 
+	if transferHash != nil {
+		err = s.send(&migration.MigrationControl{
+			Success: proto.Bool(true),
+			Message: proto.String(migrationChecksumPrefix + hex.EncodeToString(transferHash.Sum(nil))),
+		})
+		if err != nil {
+			logger.Warn("Failed sending storage volume transfer checksum", logger.Ctx{"err": err})
+		}
+	}
+
 	msg := migration.MigrationControl{}
 	err = s.recv(&msg, false)
 	if err != nil {
@@ -250,12 +279,15 @@ func (s *migrationSourceWs) DoStorage(st *state.State, projectName string, poolN
 func newStorageMigrationSink(args *migrationSinkArgs) (*migrationSink, error) {
 	sink := migrationSink{
 		migrationFields: migrationFields{
-			volumeOnly: args.VolumeOnly,
+			volumeOnly:     args.VolumeOnly,
+			transferLimit:  args.TransferLimit,
+			verifyTransfer: args.VerifyTransfer,
 		},
 		url:                 args.URL,
 		push:                args.Push,
 		refresh:             args.Refresh,
 		refreshExcludeOlder: args.RefreshExcludeOlder,
+		resume:              args.Resume,
 	}
 
 	secretNames := []string{api.SecretNameControl, api.SecretNameFilesystem}
@@ -334,6 +366,25 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 		return err
 	}
 
+	// When resuming into a volume left by an interrupted attempt, only transfer what is missing.
+	if c.resume && !c.refresh {
+		dbVol, err := storagePools.VolumeDBGet(pool, projectName, req.Name, storageDrivers.VolumeTypeCustom)
+		if err != nil && !response.IsNotFoundError(err) {
+			c.sendControl(err)
+			return err
+		}
+
+		if dbVol != nil {
+			if !storageVolumeMigrationResumable(dbVol.Config) {
+				err = api.StatusErrorf(http.StatusConflict, "Volume by that name already exists")
+				c.sendControl(err)
+				return err
+			}
+
+			c.refresh = true
+		}
+	}
+
 	// The source/sender will never set Refresh. However, to determine the correct migration type
 	// Refresh needs to be set.
 	offerHeader.Refresh = &c.refresh
@@ -343,7 +394,15 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 	// Extract the source's migration type and then match it against our pool's
 	// supported types and features. If a match is found the combined features list
 	// will be sent back to requester.
-	respTypes, err := localMigration.MatchTypes(offerHeader, storagePools.FallbackMigrationType(contentType), pool.MigrationTypes(contentType, c.refresh, !c.volumeOnly, clusterMove, poolName != "" && req.Source.Pool != poolName || !clusterMove))
+	fallbackType := storagePools.FallbackMigrationType(contentType)
+	ourTypes := pool.MigrationTypes(contentType, c.refresh, !c.volumeOnly, clusterMove, poolName != "" && req.Source.Pool != poolName || !clusterMove)
+
+	// Only the generic transfer keeps the completed snapshots when interrupted.
+	if c.resume {
+		ourTypes = slices.DeleteFunc(ourTypes, func(t localMigration.Type) bool { return t.FSType != fallbackType })
+	}
+
+	respTypes, err := localMigration.MatchTypes(offerHeader, fallbackType, ourTypes)
 	if err != nil {
 		return err
 	}
@@ -362,6 +421,10 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 	respHeader.Refresh = &c.refresh
 	respHeader.VolumeSize = offerHeader.VolumeSize
 
+	// Verify the transfer if either side asked for it.
+	c.verifyTransfer = c.verifyTransfer || offerHeader.GetVerifyTransfer()
+	respHeader.VerifyTransfer = &c.verifyTransfer
+
 	// Translate the legacy MigrationSinkArgs to a VolumeTargetArgs suitable for use
 	// with the new storage layer.
 	myTarget = func(conn io.ReadWriteCloser, op *operations.Operation, args migrationSinkArgs) error {
@@ -377,6 +440,7 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 			RefreshExcludeOlder: args.RefreshExcludeOlder,
 			VolumeSize:          args.VolumeSize,
 			VolumeOnly:          args.VolumeOnly,
+			Resume:              c.resume,
 		}
 
 		// A zero length Snapshots slice indicates volume only migration in
@@ -439,6 +503,15 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 			syncSnapshots = append(syncSnapshots, sourceSnapshots[syncSourceSnapshotIndex])
 		}
 
+		// Record which snapshots were already on the target so the resume point is visible.
+		if c.resume && op != nil {
+			err = op.ExtendMetadata(map[string]any{"resumed_snapshots": storageVolumeMigrationResumedSnapshots(sourceSnapshots, syncSnapshotNames), "pending_snapshots": syncSnapshotNames})
+			if err != nil {
+				c.sendControl(err)
+				return err
+			}
+		}
+
 		respHeader.Snapshots = syncSnapshots
 		respHeader.SnapshotNames = syncSnapshotNames
 		offerHeader.Snapshots = syncSnapshots
@@ -454,6 +527,12 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 
 	restore := make(chan error)
 
+	// Hash everything received to compare it with the checksum from the source.
+	var transferHash hash.Hash
+	if c.verifyTransfer {
+		transferHash = sha256.New()
+	}
+
 	go func(c *migrationSink) {
 		// We do the fs receive in parallel so we don't have to reason about when to receive
 		// what. The sending side is smart enough to send the filesystem bits that it can
@@ -480,6 +559,11 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 				return
 			}
 
+			fsConn = internalIO.NewRateLimitedReadWriteCloser(fsConn, c.transferLimit)
+			if transferHash != nil {
+				fsConn = internalIO.NewHashReadWriteCloser(fsConn, transferHash, nil)
+			}
+
 			err = myTarget(fsConn, op, args)
 			if err != nil {
 				fsTransfer <- err
@@ -498,6 +582,11 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 		restore <- nil
 	}(c)
 
+	var checksumTimeout <-chan time.Time
+	sourceChecksum := ""
+	restored := false
+	controlCh := c.controlChannel()
+
 	for {
 		select {
 		case err = <-restore:
@@ -506,11 +595,9 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 				return err
 			}
 
-			c.sendControl(nil)
-			logger.Debug("Migration sink finished receiving storage volume")
-
-			return nil
-		case msg := <-c.controlChannel():
+			restored = true
+			checksumTimeout = time.After(30 * time.Second)
+		case msg := <-controlCh:
 			if msg.Err != nil {
 				c.disconnect()
 
@@ -524,9 +611,55 @@ func (c *migrationSink) DoStorage(st *state.State, projectName string, poolName
 			}
 
 			// The source can only tell us it failed (e.g. if
-			// checkpointing failed). We have to tell the source
-			// whether or not the restore was successful.
-			logger.Warn("Unknown message from migration source", logger.Ctx{"message": msg.GetMessage()})
+			// checkpointing failed) or send the transfer checksum.
+			// We have to tell the source whether or not the restore
+			// was successful.
+			checksum, isChecksum := strings.CutPrefix(msg.GetMessage(), migrationChecksumPrefix)
+			if isChecksum {
+				sourceChecksum = checksum
+			} else {
+				logger.Warn("Unknown message from migration source", logger.Ctx{"message": msg.GetMessage()})
+			}
+
+			controlCh = c.controlChannel()
+		case <-checksumTimeout:
+			err := errors.New("Timed out waiting for the transfer checksum from the migration source")
+			c.sendControl(err)
+
+			return err
+		}
+
+		if !restored || (transferHash != nil && sourceChecksum == "") {
+			continue
 		}
+
+		if transferHash != nil && sourceChecksum != hex.EncodeToString(transferHash.Sum(nil)) {
+			err := errors.New("Storage volume transfer checksum mismatch")
+			c.sendControl(err)
+
+			return err
+		}
+
+		c.sendControl(nil)
+		logger.Debug("Migration sink finished receiving storage volume")
+
+		return nil
 	}
 }
+
+// storageVolumeMigrationResumable returns whether the volume was left behind by an interrupted resumable migration.
+func storageVolumeMigrationResumable(config map[string]string) bool {
+	return util.IsTrue(config[storagePools.VolumeMigrationPartialKey])
+}
+
+// storageVolumeMigrationResumedSnapshots returns the source snapshots which don't need to be sent again.
+func storageVolumeMigrationResumedSnapshots(sourceSnapshots []*migration.Snapshot, syncSnapshotNames []string) []string {
+	resumedSnapshotNames := []string{}
+	for _, sourceSnap := range sourceSnapshots {
+		if !slices.Contains(syncSnapshotNames, sourceSnap.GetName()) {
+			resumedSnapshotNames = append(resumedSnapshotNames, sourceSnap.GetName())
+		}
+	}
+
+	return resumedSnapshotNames
+}
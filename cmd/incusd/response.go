@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/lxc/incus/v7/internal/server/cluster"
+	"github.com/lxc/incus/v7/internal/server/db"
 	"github.com/lxc/incus/v7/internal/server/request"
 	"github.com/lxc/incus/v7/internal/server/response"
 	"github.com/lxc/incus/v7/internal/server/state"
@@ -64,18 +65,27 @@ func forwardedResponseIfInstanceIsRemote(s *state.State, r *http.Request, projec
 // This is used when no targetNode is specified, and saves users some typing
 // when the volume name/type is unique to a node.
 func forwardedResponseIfVolumeIsRemote(s *state.State, r *http.Request, poolName string, projectName string, volumeName string, volumeType int) response.Response {
+	resp, _ := forwardedResponseOrLocalVolume(s, r, poolName, projectName, volumeName, volumeType)
+
+	return resp
+}
+
+// forwardedResponseOrLocalVolume is like forwardedResponseIfVolumeIsRemote but also returns the volume when it
+// is served by the local member and could be loaded in the same lookup. A nil volume means the caller has to
+// load it.
+func forwardedResponseOrLocalVolume(s *state.State, r *http.Request, poolName string, projectName string, volumeName string, volumeType int) (response.Response, *db.StorageVolume) {
 	if request.QueryParam(r, "target") != "" {
-		return nil
+		return nil, nil
 	}
 
-	client, err := cluster.ConnectIfVolumeIsRemote(s, poolName, projectName, volumeName, volumeType, s.Endpoints.NetworkCert(), s.ServerCert(), r)
+	client, dbVolume, err := cluster.ConnectIfVolumeIsRemoteWithVolume(s, poolName, projectName, volumeName, volumeType, s.Endpoints.NetworkCert(), s.ServerCert(), r)
 	if err != nil {
-		return response.SmartError(err)
+		return response.SmartError(err), nil
 	}
 
 	if client == nil {
-		return nil
+		return nil, dbVolume
 	}
 
-	return response.ForwardedResponse(client, r)
+	return response.ForwardedResponse(client, r), nil
 }
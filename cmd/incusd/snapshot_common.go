@@ -25,6 +25,16 @@ var SnapshotScheduleAliases = map[string]string{
 	"@never":    "",
 }
 
+// snapshotExpiryDuration returns the expiry expression to apply to a new snapshot.
+// Scheduled snapshots only use snapshots.expiry while manual ones prefer snapshots.expiry.manual.
+func snapshotExpiryDuration(config map[string]string, manual bool) string {
+	if manual && config["snapshots.expiry.manual"] != "" {
+		return config["snapshots.expiry.manual"]
+	}
+
+	return config["snapshots.expiry"]
+}
+
 func snapshotIsScheduledNow(spec string, subjectID int64) bool {
 	result := false
 
@@ -39,6 +49,54 @@ func snapshotIsScheduledNow(spec string, subjectID int64) bool {
 	return result
 }
 
+// snapshotIsScheduledSince returns whether a snapshot was scheduled after the minute of since and up to now.
+func snapshotIsScheduledSince(spec string, subjectID int64, since time.Time, now time.Time) bool {
+	next := snapshotNextScheduled(spec, subjectID, since.Truncate(time.Minute))
+
+	return next != nil && !next.After(now)
+}
+
+// snapshotNextScheduled returns the next time after now that a snapshot is scheduled for, or nil if none is.
+func snapshotNextScheduled(spec string, subjectID int64, now time.Time) *time.Time {
+	var result *time.Time
+
+	for _, curSpec := range buildCronSpecs(spec, subjectID) {
+		next, err := gronx.NextTickAfter(curSpec, now, false)
+		if err != nil {
+			continue
+		}
+
+		if result == nil || next.Before(*result) {
+			result = &next
+		}
+	}
+
+	return result
+}
+
+// snapshotSchedulePreview returns up to count upcoming snapshot times for the schedule after now.
+func snapshotSchedulePreview(spec string, subjectID int64, now time.Time, count int) ([]time.Time, error) {
+	for _, curSpec := range buildCronSpecs(spec, subjectID) {
+		_, err := gronx.NextTickAfter(curSpec, now, false)
+		if err != nil {
+			return nil, fmt.Errorf("Failed parsing snapshot schedule %q: %w", curSpec, err)
+		}
+	}
+
+	result := []time.Time{}
+	for len(result) < count {
+		next := snapshotNextScheduled(spec, subjectID, now)
+		if next == nil {
+			break
+		}
+
+		result = append(result, *next)
+		now = *next
+	}
+
+	return result, nil
+}
+
 func buildCronSpecs(spec string, subjectID int64) []string {
 	var result []string
 
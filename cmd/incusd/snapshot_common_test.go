@@ -2,6 +2,7 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -39,6 +40,63 @@ func (s *snapshotCommonTestSuite) TestSnapshotScheduling() {
 	op.Done(nil)
 }
 
+func TestSnapshotNextScheduled(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	next := snapshotNextScheduled("0 12 * * *, 0 11 * * *", 1, now)
+	if next == nil || !next.Equal(time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Unexpected next snapshot time: %v", next)
+	}
+
+	next = snapshotNextScheduled("@never", 1, now)
+	if next != nil {
+		t.Fatalf("Expected no next snapshot time, got %v", next)
+	}
+}
+
+func TestSnapshotIsScheduledSince(t *testing.T) {
+	since := time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC)
+
+	// A tick between two runs is caught by the later one.
+	if !snapshotIsScheduledSince("2 10 * * *", 1, since, since.Add(3*time.Minute)) {
+		t.Fatal("Expected the 10:02 schedule to be due")
+	}
+
+	// The tick of the previous run's minute isn't repeated.
+	if snapshotIsScheduledSince("0 10 * * *", 1, since, since.Add(time.Minute)) {
+		t.Fatal("Expected the 10:00 schedule to already be handled")
+	}
+
+	if snapshotIsScheduledSince("0 12 * * *", 1, since, since.Add(time.Minute)) {
+		t.Fatal("Expected the 12:00 schedule not to be due")
+	}
+}
+
+func TestSnapshotExpiryDuration(t *testing.T) {
+	tests := []struct {
+		config    map[string]string
+		scheduled string
+		manual    string
+	}{
+		{map[string]string{"snapshots.expiry": "1d", "snapshots.expiry.manual": "2w"}, "1d", "2w"},
+		{map[string]string{"snapshots.expiry": "1d"}, "1d", "1d"},
+		{map[string]string{"snapshots.expiry.manual": "2w"}, "", "2w"},
+		{map[string]string{}, "", ""},
+	}
+
+	for _, test := range tests {
+		got := snapshotExpiryDuration(test.config, false)
+		if got != test.scheduled {
+			t.Errorf("Unexpected scheduled expiry for %v: got %q, expected %q", test.config, got, test.scheduled)
+		}
+
+		got = snapshotExpiryDuration(test.config, true)
+		if got != test.manual {
+			t.Errorf("Unexpected manual expiry for %v: got %q, expected %q", test.config, got, test.manual)
+		}
+	}
+}
+
 func TestSnapshotCommon(t *testing.T) {
 	suite.Run(t, &snapshotCommonTestSuite{})
 }
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/url"
 	"os"
@@ -45,6 +46,7 @@ import (
 	"github.com/lxc/incus/v7/shared/logger"
 	"github.com/lxc/incus/v7/shared/revert"
 	localtls "github.com/lxc/incus/v7/shared/tls"
+	"github.com/lxc/incus/v7/shared/units"
 	"github.com/lxc/incus/v7/shared/util"
 	"github.com/lxc/incus/v7/shared/validate"
 )
@@ -56,6 +58,12 @@ var storagePoolVolumesCmd = APIEndpoint{
 	Post: APIEndpointAction{Handler: storagePoolVolumesPost, AccessHandler: allowPermission(auth.ObjectTypeProject, auth.EntitlementCanCreateStorageVolumes), LargeRequest: true},
 }
 
+var storagePoolVolumesBulkDeleteCmd = APIEndpoint{
+	Path: "storage-pools/{poolName}/volumes/bulk-delete",
+
+	Post: APIEndpointAction{Handler: storagePoolVolumesBulkDeletePost, AccessHandler: allowAuthenticated},
+}
+
 var storagePoolVolumesTypeCmd = APIEndpoint{
 	Path: "storage-pools/{poolName}/volumes/{type}",
 
@@ -120,10 +128,30 @@ var storagePoolVolumeTypeFileCmd = APIEndpoint{
 //      type: string
 //      example: server01
 //    - in: query
+//      name: member
+//      description: Only return volumes located on this cluster member
+//      type: string
+//      example: server01
+//    - in: query
 //      name: filter
-//      description: Collection filter
+//      description: Collection filter (the computed "size_bytes" field supports gt, lt, ge and le)
 //      type: string
 //      example: default
+//    - in: query
+//      name: offset
+//      description: Number of volumes to skip (sets the X-Incus-Total-Count header)
+//      type: integer
+//      example: 100
+//    - in: query
+//      name: limit
+//      description: Maximum number of volumes to return (sets the X-Incus-Total-Count header)
+//      type: integer
+//      example: 50
+//    - in: query
+//      name: include-location
+//      description: Return objects with the URL and cluster member of each volume instead of URLs
+//      type: boolean
+//      example: true
 //  responses:
 //    "200":
 //      description: API endpoints
@@ -186,10 +214,25 @@ var storagePoolVolumeTypeFileCmd = APIEndpoint{
 //      type: string
 //      example: server01
 //    - in: query
+//      name: member
+//      description: Only return volumes located on this cluster member
+//      type: string
+//      example: server01
+//    - in: query
 //      name: filter
-//      description: Collection filter
+//      description: Collection filter (the computed "size_bytes" field supports gt, lt, ge and le)
 //      type: string
 //      example: default
+//    - in: query
+//      name: offset
+//      description: Number of volumes to skip (sets the X-Incus-Total-Count header)
+//      type: integer
+//      example: 100
+//    - in: query
+//      name: limit
+//      description: Maximum number of volumes to return (sets the X-Incus-Total-Count header)
+//      type: integer
+//      example: 50
 //  responses:
 //    "200":
 //      description: API endpoints
@@ -249,6 +292,11 @@ var storagePoolVolumeTypeFileCmd = APIEndpoint{
 //      description: Cluster member name
 //      type: string
 //      example: server01
+//    - in: query
+//      name: member
+//      description: Only return volumes located on this cluster member
+//      type: string
+//      example: server01
 //  responses:
 //    "200":
 //      description: API endpoints
@@ -313,6 +361,11 @@ var storagePoolVolumeTypeFileCmd = APIEndpoint{
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: member
+//	    description: Only return volumes located on this cluster member
+//	    type: string
+//	    example: server01
 //	responses:
 //	  "200":
 //	    description: API endpoints
@@ -372,6 +425,11 @@ var storagePoolVolumeTypeFileCmd = APIEndpoint{
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: member
+//	    description: Only return volumes located on this cluster member
+//	    type: string
+//	    example: server01
 //	responses:
 //	  "200":
 //	    description: API endpoints
@@ -410,6 +468,10 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 
 	targetMember := request.QueryParam(r, "target")
 	memberSpecific := targetMember != ""
+	memberFilter := request.QueryParam(r, "member")
+	if memberFilter != "" && !s.ServerClustered {
+		return response.BadRequest(errors.New("The member filter requires a cluster"))
+	}
 
 	poolName, err := pathVar(r, "poolName")
 	if err != nil {
@@ -437,6 +499,11 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(fmt.Errorf("Invalid filter: %w", err))
 	}
 
+	offset, limit, paginated, err := storagePoolVolumesPagination(r)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	// Retrieve the storage pool (and check if the storage pool exists).
 	pool, err := storagePools.LoadByName(s, poolName)
 	if err != nil {
@@ -522,6 +589,13 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 			}
 		}
 
+		if memberFilter != "" {
+			_, err = tx.GetNodeByName(ctx, memberFilter)
+			if err != nil {
+				return fmt.Errorf("Failed loading cluster member %q: %w", memberFilter, err)
+			}
+		}
+
 		dbVolumes, err = tx.GetStoragePoolVolumes(ctx, pool.ID(), memberSpecific, filters...)
 		if err != nil {
 			return fmt.Errorf("Failed loading storage volumes: %w", err)
@@ -533,15 +607,47 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
+	// Only keep the volumes located on the requested member, volumes on remote pools aren't tied to one.
+	if memberFilter != "" && !pool.Driver().Info().Remote {
+		memberVolumes := make([]*db.StorageVolume, 0, len(dbVolumes))
+		for _, vol := range dbVolumes {
+			if vol.Location == memberFilter {
+				memberVolumes = append(memberVolumes, vol)
+			}
+		}
+
+		dbVolumes = memberVolumes
+	}
+
+	// Memoize UsedBy so it's computed at most once per volume for this request.
+	usedByCache := map[string][]string{}
+	getUsedBy := func(vol *db.StorageVolume) ([]string, error) {
+		// Use the full name so that snapshots don't share the entry of their parent.
+		key := strings.Join([]string{poolName, vol.Type, vol.Project, vol.Name, vol.Location}, "/")
+
+		usedBy, ok := usedByCache[key]
+		if ok {
+			return usedBy, nil
+		}
+
+		volumeUsedBy, err := storagePoolVolumeUsedByGet(s, requestProjectName, poolName, vol)
+		if err != nil {
+			return nil, err
+		}
+
+		usedBy = project.FilterUsedBy(s.Authorizer, r, volumeUsedBy)
+		usedByCache[key] = usedBy
+
+		return usedBy, nil
+	}
+
 	// Pre-fill UsedBy if using filtering.
 	if clauses != nil && len(clauses.Clauses) > 0 {
 		for i, vol := range dbVolumes {
-			volumeUsedBy, err := storagePoolVolumeUsedByGet(s, requestProjectName, poolName, vol)
+			dbVolumes[i].UsedBy, err = getUsedBy(vol)
 			if err != nil {
 				return response.InternalError(err)
 			}
-
-			dbVolumes[i].UsedBy = project.FilterUsedBy(s.Authorizer, r, volumeUsedBy)
 		}
 	}
 
@@ -568,6 +674,37 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
+	// Only keep the volumes the requestor is allowed to see.
+	visibleVolumes := make([]*db.StorageVolume, 0, len(dbVolumes))
+	for _, dbVol := range dbVolumes {
+		volumeName, snapName, _ := api.GetParentAndSnapshotName(dbVol.Name)
+		if snapName != "" {
+			continue
+		}
+
+		var location string
+		if s.ServerClustered && !pool.Driver().Info().Remote {
+			location = dbVol.Location
+		}
+
+		if !userHasPermission(auth.ObjectStorageVolume(dbVol.Project, poolName, dbVol.Type, volumeName, location)) {
+			continue
+		}
+
+		visibleVolumes = append(visibleVolumes, dbVol)
+	}
+
+	// Only return the requested page, reporting the full count in a header.
+	headers := map[string]string{}
+	if paginated {
+		headers["X-Incus-Total-Count"] = strconv.Itoa(len(visibleVolumes))
+
+		visibleVolumes = visibleVolumes[min(offset, len(visibleVolumes)):]
+		if limit >= 0 && limit < len(visibleVolumes) {
+			visibleVolumes = visibleVolumes[:limit]
+		}
+	}
+
 	recursionStr := r.FormValue("recursion")
 
 	recursion, err := strconv.Atoi(recursionStr)
@@ -576,32 +713,13 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 	}
 
 	if recursion > 0 {
-		volumes := make([]*api.StorageVolume, 0, len(dbVolumes))
-		for _, dbVol := range dbVolumes {
+		volumes := make([]*api.StorageVolume, 0, len(visibleVolumes))
+		for _, dbVol := range visibleVolumes {
 			vol := &dbVol.StorageVolume
 
-			var location string
-			if s.ServerClustered && !pool.Driver().Info().Remote {
-				location = vol.Location
-			}
-
-			volumeName, snapName, _ := api.GetParentAndSnapshotName(vol.Name)
-			if snapName != "" {
-				continue
-			}
-
-			if !userHasPermission(auth.ObjectStorageVolume(vol.Project, poolName, dbVol.Type, volumeName, location)) {
-				continue
-			}
-
-			// Fill in UsedBy if we haven't previously done so.
-			if clauses == nil || len(clauses.Clauses) == 0 {
-				volumeUsedBy, err := storagePoolVolumeUsedByGet(s, requestProjectName, poolName, dbVol)
-				if err != nil {
-					return response.InternalError(err)
-				}
-
-				vol.UsedBy = project.FilterUsedBy(s.Authorizer, r, volumeUsedBy)
+			vol.UsedBy, err = getUsedBy(dbVol)
+			if err != nil {
+				return response.InternalError(err)
 			}
 
 			volumes = append(volumes, vol)
@@ -646,41 +764,79 @@ func storagePoolVolumesGet(d *Daemon, r *http.Request) response.Response {
 				volumesFull = append(volumesFull, fullVol)
 			}
 
-			return response.SyncResponse(true, volumesFull)
+			return response.SyncResponseHeaders(true, volumesFull, headers)
 		}
 
-		return response.SyncResponse(true, volumes)
+		return response.SyncResponseHeaders(true, volumes, headers)
 	}
 
-	urls := make([]string, 0, len(dbVolumes))
-	for _, dbVol := range dbVolumes {
-		volumeName, snapName, _ := api.GetParentAndSnapshotName(dbVol.Name)
-		if snapName != "" {
-			continue
-		}
+	// Report the cluster member of each volume alongside its URL if requested.
+	if util.IsTrue(request.QueryParam(r, "include-location")) {
+		volumeLocations := make([]api.StorageVolumeLocation, 0, len(visibleVolumes))
+		for _, dbVol := range visibleVolumes {
+			var location string
+			if s.ServerClustered && !pool.Driver().Info().Remote {
+				location = dbVol.Location
+			}
 
-		var location string
-		if s.ServerClustered && !pool.Driver().Info().Remote {
-			location = dbVol.Location
+			volumeLocations = append(volumeLocations, api.StorageVolumeLocation{
+				URL:      dbVol.StorageVolume.URL(version.APIVersion, poolName).String(),
+				Location: location,
+			})
 		}
 
-		if !userHasPermission(auth.ObjectStorageVolume(dbVol.Project, poolName, dbVol.Type, volumeName, location)) {
-			continue
-		}
+		return response.SyncResponseHeaders(true, volumeLocations, headers)
+	}
 
+	urls := make([]string, 0, len(visibleVolumes))
+	for _, dbVol := range visibleVolumes {
 		urls = append(urls, dbVol.StorageVolume.URL(version.APIVersion, poolName).String())
 	}
 
-	return response.SyncResponse(true, urls)
+	return response.SyncResponseHeaders(true, urls, headers)
+}
+
+// storagePoolVolumesPagination parses the optional offset and limit query parameters.
+// A negative limit means no limit.
+func storagePoolVolumesPagination(r *http.Request) (int, int, bool, error) {
+	offsetStr := request.QueryParam(r, "offset")
+	limitStr := request.QueryParam(r, "limit")
+
+	if offsetStr == "" && limitStr == "" {
+		return 0, -1, false, nil
+	}
+
+	offset := 0
+	if offsetStr != "" {
+		var err error
+
+		offset, err = strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return 0, 0, false, fmt.Errorf("Invalid offset %q", offsetStr)
+		}
+	}
+
+	limit := -1
+	if limitStr != "" {
+		var err error
+
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 0 {
+			return 0, 0, false, fmt.Errorf("Invalid limit %q", limitStr)
+		}
+	}
+
+	return offset, limit, true, nil
 }
 
 // filterVolumes returns a filtered list of volumes that match the given clauses.
 func filterVolumes(volumes []*db.StorageVolume, clauses *filter.ClauseSet, allProjects bool, filterProjectImages []string) ([]*db.StorageVolume, error) {
 	// FilterStorageVolume is for filtering purpose only.
-	// It allows to filter snapshots by using default filter mechanism.
+	// It allows to filter snapshots and sizes by using default filter mechanism.
 	type FilterStorageVolume struct {
 		api.StorageVolume `yaml:",inline"`
 		Snapshot          string `yaml:"snapshot"`
+		SizeBytes         int64  `yaml:"size_bytes"`
 	}
 
 	filtered := []*db.StorageVolume{}
@@ -690,9 +846,16 @@ func filterVolumes(volumes []*db.StorageVolume, clauses *filter.ClauseSet, allPr
 			continue
 		}
 
+		// Volumes without a (valid) size are treated as having a size of 0.
+		sizeBytes, err := units.ParseByteSizeString(volume.Config["size"])
+		if err != nil || sizeBytes < 0 {
+			sizeBytes = 0
+		}
+
 		tmpVolume := FilterStorageVolume{
 			StorageVolume: volume.StorageVolume,
 			Snapshot:      strconv.FormatBool(strings.Contains(volume.Name, internalInstance.SnapshotDelimiter)),
+			SizeBytes:     sizeBytes,
 		}
 
 		match, err := filter.Match(tmpVolume, *clauses)
@@ -848,7 +1011,8 @@ func storagePoolVolumesPost(d *Daemon, r *http.Request) response.Response {
 	}
 
 	// Backward compatibility.
-	if req.ContentType == "" {
+	contentTypeDefaulted := req.ContentType == ""
+	if contentTypeDefaulted {
 		req.ContentType = db.StoragePoolVolumeContentTypeNameFS
 	}
 
@@ -895,7 +1059,7 @@ func storagePoolVolumesPost(d *Daemon, r *http.Request) response.Response {
 	})
 	if err != nil {
 		return response.SmartError(err)
-	} else if dbVolume != nil && !req.Source.Refresh {
+	} else if dbVolume != nil && !req.Source.Refresh && !(req.Source.Resume && req.Source.Type == "migration" && storageVolumeMigrationResumable(dbVolume.Config)) {
 		return response.Conflict(errors.New("Volume by that name already exists"))
 	}
 
@@ -933,7 +1097,7 @@ func storagePoolVolumesPost(d *Daemon, r *http.Request) response.Response {
 		}
 
 		return doVolumeCreateOrCopy(s, r, request.ProjectParam(r), projectName, poolName, &req)
-	case "copy":
+	case "copy", "clone":
 		// Check that the caller is allowed to view the source volume.
 		srcProjectName := projectName
 		if req.Source.Project != "" {
@@ -953,13 +1117,40 @@ func storagePoolVolumesPost(d *Daemon, r *http.Request) response.Response {
 			return response.SmartError(err)
 		}
 
+		// For cross-project copies, make sure the volume really ends up in the requested project.
+		if req.Source.Project != "" && req.Source.Project != request.ProjectParam(r) {
+			// A mismatch means the target project doesn't have features.storage.volumes and the
+			// copy would silently land in the default project instead.
+			if projectName != request.ProjectParam(r) {
+				return response.BadRequest(fmt.Errorf("Target project %q does not have features.storage.volumes enabled", request.ProjectParam(r)))
+			}
+
+			// Check if user has access to effective storage target project.
+			err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectProject(projectName), auth.EntitlementCanCreateStorageVolumes)
+			if err != nil {
+				return response.SmartError(err)
+			}
+		}
+
 		if dbVolume != nil {
 			return doCustomVolumeRefresh(s, r, request.ProjectParam(r), projectName, poolName, &req)
 		}
 
+		// Copies keep the source content type unless one was explicitly requested.
+		if contentTypeDefaulted {
+			req.ContentType = ""
+		}
+
 		return doVolumeCreateOrCopy(s, r, request.ProjectParam(r), projectName, poolName, &req)
 	case "migration":
 		return doVolumeMigration(s, r, request.ProjectParam(r), projectName, poolName, &req)
+	case "image":
+		err = validateCreateConfig(req.Config)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		return doVolumeCreateFromImage(s, r, request.ProjectParam(r), projectName, poolName, &req)
 	default:
 		return response.BadRequest(fmt.Errorf("Unknown source type %q", req.Source.Type))
 	}
@@ -1084,25 +1275,76 @@ func doVolumeCreateOrCopy(s *state.State, r *http.Request, requestProjectName st
 		}
 	}
 
-	volumeDBContentType, err := storagePools.VolumeContentTypeNameToContentType(req.ContentType)
-	if err != nil {
-		return response.SmartError(err)
+	var contentType storageDrivers.ContentType
+	if req.ContentType != "" {
+		volumeDBContentType, err := storagePools.VolumeContentTypeNameToContentType(req.ContentType)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		contentType, err = storagePools.VolumeDBContentTypeToContentType(volumeDBContentType)
+		if err != nil {
+			return response.SmartError(err)
+		}
 	}
 
-	contentType, err := storagePools.VolumeDBContentTypeToContentType(volumeDBContentType)
-	if err != nil {
-		return response.SmartError(err)
+	// Check whether the copy converts the source volume into another content type.
+	converting := false
+	if req.Source.Name != "" && contentType != "" {
+		srcPoolName := req.Source.Pool
+		if srcPoolName == "" {
+			srcPoolName = poolName
+		}
+
+		srcContentType, err := storagePoolVolumeContentType(r.Context(), s, srcPoolName, srcProjectName, projectName, req.Source.Name)
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		if srcContentType != contentType {
+			err = storagePools.ValidateCustomVolumeConversion(srcContentType, contentType)
+			if err != nil {
+				return response.BadRequest(err)
+			}
+
+			if len(pool.MigrationTypes(contentType, false, false, false, true)) == 0 {
+				return response.BadRequest(fmt.Errorf("Storage pool %q (driver %q) doesn't support %q volumes", pool.Name(), pool.Driver().Info().Name, contentType))
+			}
+
+			converting = true
+		}
+	}
+
+	// Validate new volumes against the content type's requirements.
+	if req.Source.Name == "" && contentType != "" {
+		err = storagePoolVolumeValidateCreate(pool, projectName, req.Name, contentType, req.Config)
+		if err != nil {
+			return response.BadRequest(err)
+		}
 	}
 
 	run := func(op *operations.Operation) error {
+		var err error
+
 		if req.Source.Name == "" {
 			// Use an empty operation for this sync response to pass the requestor
 			op := &operations.Operation{}
 			op.SetRequestor(r)
-			return pool.CreateCustomVolume(projectName, req.Name, req.Description, req.Config, contentType, op)
+			err = pool.CreateCustomVolume(projectName, req.Name, req.Description, req.Config, contentType, op)
+		} else if converting {
+			err = pool.ConvertCustomVolumeFromCopy(projectName, srcProjectName, req.Name, req.Description, req.Config, req.Source.Pool, req.Source.Name, contentType, op)
+		} else if req.Source.Type == "clone" {
+			var cloned bool
+			cloned, err = pool.CloneCustomVolume(projectName, srcProjectName, req.Name, req.Description, req.Config, req.Source.Pool, req.Source.Name, !req.Source.VolumeOnly, op)
+			if err == nil {
+				// Report whether the driver's clone primitive or a full copy was used.
+				err = op.ExtendMetadata(map[string]any{"optimized_clone": cloned})
+			}
+		} else {
+			err = pool.CreateCustomVolumeFromCopy(projectName, srcProjectName, req.Name, req.Description, req.Config, req.Source.Pool, req.Source.Name, !req.Source.VolumeOnly, op)
 		}
 
-		return pool.CreateCustomVolumeFromCopy(projectName, srcProjectName, req.Name, req.Description, req.Config, req.Source.Pool, req.Source.Name, !req.Source.VolumeOnly, op)
+		return storagePoolVolumeSpaceError(pool, err)
 	}
 
 	// If no source name supplied then this a volume create operation.
@@ -1124,6 +1366,119 @@ func doVolumeCreateOrCopy(s *state.State, r *http.Request, requestProjectName st
 	return operations.OperationResponse(op)
 }
 
+// storagePoolVolumeContentType returns the content type of an existing custom volume.
+func storagePoolVolumeContentType(ctx context.Context, s *state.State, poolName string, projectName string, defaultProjectName string, volName string) (storageDrivers.ContentType, error) {
+	if projectName == "" {
+		projectName = defaultProjectName
+	}
+
+	var dbVolume *db.StorageVolume
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		poolID, err := tx.GetStoragePoolID(ctx, poolName)
+		if err != nil {
+			return err
+		}
+
+		dbVolume, err = tx.GetStoragePoolVolume(ctx, poolID, projectName, db.StoragePoolVolumeTypeCustom, volName, true)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	volumeDBContentType, err := storagePools.VolumeContentTypeNameToContentType(dbVolume.ContentType)
+	if err != nil {
+		return "", err
+	}
+
+	return storagePools.VolumeDBContentTypeToContentType(volumeDBContentType)
+}
+
+func doVolumeCreateFromImage(s *state.State, r *http.Request, requestProjectName string, projectName string, poolName string, req *api.StorageVolumesPost) response.Response {
+	if req.ContentType != db.StoragePoolVolumeContentTypeNameFS {
+		return response.BadRequest(fmt.Errorf("Custom volumes created from an image must use the %q content type", db.StoragePoolVolumeContentTypeNameFS))
+	}
+
+	if req.Source.Fingerprint == "" {
+		return response.BadRequest(errors.New("No source image fingerprint supplied"))
+	}
+
+	pool, err := storagePools.LoadByName(s, poolName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	source := api.InstanceSource{
+		Type:        "image",
+		Fingerprint: req.Source.Fingerprint,
+		Server:      req.Source.Server,
+		Protocol:    req.Source.Protocol,
+		Certificate: req.Source.Certificate,
+	}
+
+	var p *api.Project
+	var img *api.Image
+	imgRef := req.Source.Fingerprint
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		dbProject, err := dbCluster.GetProject(ctx, tx.Tx(), requestProjectName)
+		if err != nil {
+			return fmt.Errorf("Failed loading project: %w", err)
+		}
+
+		p, err = dbProject.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return err
+		}
+
+		// Remote images may not have been downloaded yet.
+		img, err = getSourceImageFromInstanceSource(ctx, s, tx, p.Name, source, &imgRef, string(api.InstanceTypeContainer))
+		if err != nil && (source.Server == "" || !response.IsNotFoundError(err)) {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if img != nil && img.Type != string(api.InstanceTypeContainer) {
+		return response.BadRequest(fmt.Errorf("Image %q isn't a container image", img.Fingerprint))
+	}
+
+	run := func(op *operations.Operation) error {
+		if source.Server != "" {
+			img, err = ensureDownloadedImageFitWithinBudget(context.TODO(), s, r, op, *p, imgRef, source, string(api.InstanceTypeContainer))
+			if err != nil {
+				return err
+			}
+		} else {
+			err = ensureImageIsLocallyAvailable(context.TODO(), s, r, img, p.Name)
+			if err != nil {
+				return err
+			}
+		}
+
+		if img.Type != string(api.InstanceTypeContainer) {
+			return fmt.Errorf("Image %q isn't a container image", img.Fingerprint)
+		}
+
+		return pool.CreateCustomVolumeFromImage(projectName, req.Name, req.Description, req.Config, img.Fingerprint, op)
+	}
+
+	resources := map[string][]api.URL{}
+	resources["storage_volumes"] = []api.URL{*api.NewURL().Path(version.APIVersion, "storage-pools", poolName, "volumes", "custom", req.Name).Project(projectName)}
+
+	op, err := operations.OperationCreate(s, requestProjectName, operations.OperationClassTask, operationtype.VolumeCreate, resources, nil, run, nil, nil, r)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	return operations.OperationResponse(op)
+}
+
 func doVolumeMigration(s *state.State, r *http.Request, requestProjectName string, projectName string, poolName string, req *api.StorageVolumesPost) response.Response {
 	// Validate migration mode
 	if req.Source.Mode != "pull" && req.Source.Mode != "push" {
@@ -1166,6 +1521,9 @@ func doVolumeMigration(s *state.State, r *http.Request, requestProjectName strin
 		VolumeOnly:          req.Source.VolumeOnly,
 		Refresh:             req.Source.Refresh,
 		RefreshExcludeOlder: req.Source.RefreshExcludeOlder,
+		TransferLimit:       req.Source.Limits,
+		VerifyTransfer:      req.Source.VerifyTransfer,
+		Resume:              req.Source.Resume,
 	}
 
 	sink, err := newStorageMigrationSink(&migrationArgs)
@@ -1535,6 +1893,8 @@ func storagePoolVolumePost(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
+	var targetExists bool
+
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
 		// Check that the name isn't already in use.
 		_, err = tx.GetStoragePoolNodeVolumeID(ctx, targetProjectName, req.Name, volumeType, targetPoolID)
@@ -1546,7 +1906,14 @@ func storagePoolVolumePost(d *Daemon, r *http.Request) response.Response {
 			return response.InternalError(err)
 		}
 
-		return response.Conflict(errors.New("Volume by that name already exists"))
+		isMove := (req.Pool != "" && req.Pool != srcPoolName) || projectName != targetProjectName
+
+		// An existing target volume is only allowed when refreshing it as part of a move.
+		if !req.Refresh || !isMove {
+			return response.Conflict(errors.New("Volume by that name already exists"))
+		}
+
+		targetExists = true
 	}
 
 	// Check if the daemon itself is using it.
@@ -1612,7 +1979,7 @@ func storagePoolVolumePost(d *Daemon, r *http.Request) response.Response {
 	}
 
 	// Otherwise this is a move request.
-	return storagePoolVolumeTypePostMove(s, r, srcPoolName, projectName, targetProjectName, &dbVolume.StorageVolume, req)
+	return storagePoolVolumeTypePostMove(s, r, srcPoolName, projectName, targetProjectName, &dbVolume.StorageVolume, req, targetExists)
 }
 
 func migrateStorageVolume(s *state.State, r *http.Request, sourceVolumeName string, sourcePoolName string, targetNode string, projectName string, req api.StorageVolumePost, op *operations.Operation) error {
@@ -1739,6 +2106,9 @@ func storageVolumePostClusteringMigrate(s *state.State, r *http.Request, srcPool
 			},
 		})
 		if err != nil {
+			// Stop the source side as the destination will never connect to it.
+			storageVolumeMigrateAbortSource(srcOp, srcMigration)
+
 			return fmt.Errorf("Failed requesting volume create on destination: %w", err)
 		}
 
@@ -1748,6 +2118,18 @@ func storageVolumePostClusteringMigrate(s *state.State, r *http.Request, srcPool
 	return run, nil
 }
 
+// storageVolumeMigrateAbortSource cancels a source migration operation whose destination failed.
+// The source volume is left untouched as it's only deleted once the transfer has completed.
+func storageVolumeMigrateAbortSource(srcOp *operations.Operation, srcMigration *migrationSourceWs) {
+	_, err := srcOp.Cancel()
+	if err != nil {
+		logger.Warn("Failed cancelling storage volume migration source operation", logger.Ctx{"operation": srcOp.ID(), "err": err})
+
+		// Make sure the websockets are closed even if the operation couldn't be cancelled.
+		srcMigration.disconnect()
+	}
+}
+
 // storagePoolVolumeTypePostMigration handles volume migration type POST requests.
 func storagePoolVolumeTypePostMigration(s *state.State, r *http.Request, requestProjectName string, projectName string, poolName string, volumeName string, req api.StorageVolumePost) response.Response {
 	ws, err := newStorageMigrationSource(req.VolumeOnly, req.Target)
@@ -1755,6 +2137,9 @@ func storagePoolVolumeTypePostMigration(s *state.State, r *http.Request, request
 		return response.InternalError(err)
 	}
 
+	ws.transferLimit = req.Source.Limits
+	ws.verifyTransfer = req.Source.VerifyTransfer
+
 	resources := map[string][]api.URL{}
 	srcVolParentName, srcVolSnapName, srcIsSnapshot := api.GetParentAndSnapshotName(volumeName)
 	if srcIsSnapshot {
@@ -1796,11 +2181,35 @@ func storagePoolVolumeTypePostRename(s *state.State, r *http.Request, poolName s
 		return response.SmartError(err)
 	}
 
-	reverter := revert.New()
-	defer reverter.Fail()
+	// A name with a snapshot separator would land in another volume's snapshot namespace.
+	if internalInstance.IsSnapshot(req.Name) {
+		return response.BadRequest(errors.New("New volume name cannot be a snapshot"))
+	}
 
-	// Update devices using the volume in instances and profiles.
-	err = storagePoolVolumeUpdateUsers(r.Context(), s, projectName, pool.Name(), vol, pool.Name(), &newVol)
+	// Check that the new name is free, as not all callers check it before renaming.
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		_, err := tx.GetStoragePoolNodeVolumeID(ctx, projectName, req.Name, db.StoragePoolVolumeTypeCustom, pool.ID())
+
+		return err
+	})
+	if !response.IsNotFoundError(err) {
+		if err != nil {
+			return response.InternalError(err)
+		}
+
+		return response.Conflict(errors.New("Volume by that name already exists"))
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Restore the device references if the update or the rename fails part way.
+	reverter.Add(func() {
+		_ = storagePoolVolumeUpdateUsers(context.TODO(), s, projectName, pool.Name(), &newVol, pool.Name(), vol)
+	})
+
+	// Update devices using the volume in instances and profiles.
+	err = storagePoolVolumeUpdateUsers(r.Context(), s, projectName, pool.Name(), vol, pool.Name(), &newVol)
 	if err != nil {
 		return response.SmartError(err)
 	}
@@ -1822,7 +2231,8 @@ func storagePoolVolumeTypePostRename(s *state.State, r *http.Request, poolName s
 }
 
 // storagePoolVolumeTypePostMove handles volume move type POST requests.
-func storagePoolVolumeTypePostMove(s *state.State, r *http.Request, poolName string, requestProjectName string, projectName string, vol *api.StorageVolume, req api.StorageVolumePost) response.Response {
+// When refresh is set, the existing target volume is incrementally refreshed rather than fully copied.
+func storagePoolVolumeTypePostMove(s *state.State, r *http.Request, poolName string, requestProjectName string, projectName string, vol *api.StorageVolume, req api.StorageVolumePost, refresh bool) response.Response {
 	newVol := *vol
 	newVol.Name = req.Name
 
@@ -1850,13 +2260,19 @@ func storagePoolVolumeTypePostMove(s *state.State, r *http.Request, poolName str
 			_ = storagePoolVolumeUpdateUsers(context.TODO(), s, projectName, newPool.Name(), &newVol, pool.Name(), vol)
 		})
 
-		// Provide empty description and nil config to instruct CreateCustomVolumeFromCopy to copy it
-		// from source volume.
-		err = newPool.CreateCustomVolumeFromCopy(projectName, requestProjectName, newVol.Name, "", nil, pool.Name(), vol.Name, true, op)
+		// Provide empty description and nil config to instruct CreateCustomVolumeFromCopy and
+		// RefreshCustomVolume to copy it from source volume.
+		if refresh {
+			err = newPool.RefreshCustomVolume(projectName, requestProjectName, newVol.Name, "", nil, pool.Name(), vol.Name, true, false, op)
+		} else {
+			err = newPool.CreateCustomVolumeFromCopy(projectName, requestProjectName, newVol.Name, "", nil, pool.Name(), vol.Name, true, op)
+		}
+
 		if err != nil {
 			return err
 		}
 
+		// Only remove the source once the target is fully in sync.
 		err = pool.DeleteCustomVolume(requestProjectName, vol.Name, op)
 		if err != nil {
 			return err
@@ -1970,6 +2386,21 @@ func storagePoolVolumeTypePostMove(s *state.State, r *http.Request, poolName str
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: usedby-type
+//	    description: Only return used-by entries of this entity type
+//	    type: string
+//	    example: instance
+//	  - in: query
+//	    name: schedule-preview
+//	    description: Number of upcoming scheduled snapshot times to return
+//	    type: integer
+//	    example: 5
+//	  - in: query
+//	    name: include-snapshots-summary
+//	    description: Include the snapshot count and latest snapshot time
+//	    type: boolean
+//	    example: true
 //	responses:
 //	  "200":
 //	    description: Storage volume
@@ -2037,29 +2468,27 @@ func storagePoolVolumeGet(d *Daemon, r *http.Request) response.Response {
 		return resp
 	}
 
-	resp = forwardedResponseIfVolumeIsRemote(s, r, poolName, volumeProjectName, volumeName, volumeType)
+	resp, dbVolume := forwardedResponseOrLocalVolume(s, r, poolName, volumeProjectName, volumeName, volumeType)
 	if resp != nil {
 		return resp
 	}
 
-	var dbVolume *db.StorageVolume
-	var poolID int64
-
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		var err error
+	// Load the volume if it wasn't already while looking up its location.
+	if dbVolume == nil {
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			// Get the ID of the storage pool the storage volume is supposed to be attached to.
+			poolID, err := tx.GetStoragePoolID(ctx, poolName)
+			if err != nil {
+				return err
+			}
 
-		// Get the ID of the storage pool the storage volume is supposed to be attached to.
-		poolID, err = tx.GetStoragePoolID(ctx, poolName)
-		if err != nil {
+			// Get the storage volume.
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, poolID, volumeProjectName, volumeType, volumeName, true)
 			return err
+		})
+		if err != nil {
+			return response.SmartError(err)
 		}
-
-		// Get the storage volume.
-		dbVolume, err = tx.GetStoragePoolVolume(ctx, poolID, volumeProjectName, volumeType, volumeName, true)
-		return err
-	})
-	if err != nil {
-		return response.SmartError(err)
 	}
 
 	volumeUsedBy, err := storagePoolVolumeUsedByGet(s, requestProjectName, poolName, dbVolume)
@@ -2068,8 +2497,63 @@ func storagePoolVolumeGet(d *Daemon, r *http.Request) response.Response {
 	}
 
 	dbVolume.UsedBy = project.FilterUsedBy(s.Authorizer, r, volumeUsedBy)
+
+	// Only keep the used-by entries of the requested entity type.
+	usedByType := request.QueryParam(r, "usedby-type")
+	if usedByType != "" {
+		dbVolume.UsedBy, err = storagePoolVolumeUsedByFilterType(dbVolume.UsedBy, usedByType)
+		if err != nil {
+			return response.BadRequest(err)
+		}
+	}
+
 	etag := []any{volumeName, dbVolume.Type, dbVolume.Config}
 
+	// Report when the next scheduled snapshot will be taken.
+	schedule := dbVolume.Config["snapshots.schedule"]
+	if schedule != "" {
+		dbVolume.NextSnapshotAt = snapshotNextScheduled(schedule, dbVolume.ID, time.Now())
+	}
+
+	// Preview the upcoming scheduled snapshots if requested.
+	schedulePreview := request.QueryParam(r, "schedule-preview")
+	if schedulePreview != "" {
+		count, err := strconv.Atoi(schedulePreview)
+		if err != nil || count < 1 || count > 100 {
+			return response.BadRequest(fmt.Errorf("Invalid schedule-preview value %q, must be between 1 and 100", schedulePreview))
+		}
+
+		dbVolume.SnapshotSchedulePreview, err = snapshotSchedulePreview(schedule, dbVolume.ID, time.Now(), count)
+		if err != nil {
+			return response.BadRequest(err)
+		}
+	}
+
+	// Summarize the volume snapshots if requested.
+	if util.IsTrue(request.QueryParam(r, "include-snapshots-summary")) {
+		var snapshots []db.StorageVolumeArgs
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			poolID, err := tx.GetStoragePoolID(ctx, poolName)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err = tx.GetLocalStoragePoolVolumeSnapshotsWithType(ctx, volumeProjectName, volumeName, volumeType, poolID)
+			return err
+		})
+		if err != nil {
+			return response.SmartError(err)
+		}
+
+		count := len(snapshots)
+		dbVolume.SnapshotCount = &count
+
+		// Snapshots are ordered oldest first.
+		if count > 0 {
+			dbVolume.LatestSnapshotAt = &snapshots[count-1].CreationDate
+		}
+	}
+
 	// Prepare the response.
 	if localUtil.IsRecursionRequest(r) {
 		volFull, err := getVolumeFull(r.Context(), s, poolName, dbVolume.StorageVolume)
@@ -2292,19 +2776,20 @@ func storagePoolVolumePut(d *Daemon, r *http.Request) response.Response {
 		return resp
 	}
 
-	resp = forwardedResponseIfVolumeIsRemote(s, r, pool.Name(), projectName, volumeName, volumeType)
+	resp, dbVolume := forwardedResponseOrLocalVolume(s, r, pool.Name(), projectName, volumeName, volumeType)
 	if resp != nil {
 		return resp
 	}
 
-	// Get the existing storage volume.
-	var dbVolume *db.StorageVolume
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), projectName, volumeType, volumeName, true)
-		return err
-	})
-	if err != nil {
-		return response.SmartError(err)
+	// Get the existing storage volume if it wasn't already loaded while looking up its location.
+	if dbVolume == nil {
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), projectName, volumeType, volumeName, true)
+			return err
+		})
+		if err != nil {
+			return response.SmartError(err)
+		}
 	}
 
 	// Validate the ETag
@@ -2484,19 +2969,20 @@ func storagePoolVolumePatch(d *Daemon, r *http.Request) response.Response {
 		return resp
 	}
 
-	resp = forwardedResponseIfVolumeIsRemote(s, r, pool.Name(), projectName, volumeName, volumeType)
+	resp, dbVolume := forwardedResponseOrLocalVolume(s, r, pool.Name(), projectName, volumeName, volumeType)
 	if resp != nil {
 		return resp
 	}
 
-	// Get the existing storage volume.
-	var dbVolume *db.StorageVolume
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), projectName, volumeType, volumeName, true)
-		return err
-	})
-	if err != nil {
-		return response.SmartError(err)
+	// Get the existing storage volume if it wasn't already loaded while looking up its location.
+	if dbVolume == nil {
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), projectName, volumeType, volumeName, true)
+			return err
+		})
+		if err != nil {
+			return response.SmartError(err)
+		}
 	}
 
 	// Validate the ETag.
@@ -2525,6 +3011,12 @@ func storagePoolVolumePatch(d *Daemon, r *http.Request) response.Response {
 		}
 	}
 
+	// Validate the merged config up front so an invalid key is reported as a bad request.
+	err = storagePoolVolumeValidateConfig(pool, projectName, dbVolume, req.Config)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	// Use an empty operation for this sync response to pass the requestor
 	op := &operations.Operation{}
 	op.SetRequestor(r)
@@ -2572,6 +3064,11 @@ func storagePoolVolumePatch(d *Daemon, r *http.Request) response.Response {
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: force
+//	    description: Delete the volume even if it is still in use
+//	    type: boolean
+//	    example: false
 //	responses:
 //	  "200":
 //	    $ref: "#/responses/EmptySyncResponse"
@@ -2627,7 +3124,7 @@ func storagePoolVolumeDelete(d *Daemon, r *http.Request) response.Response {
 		return resp
 	}
 
-	resp = forwardedResponseIfVolumeIsRemote(s, r, poolName, volumeProjectName, volumeName, volumeType)
+	resp, dbVolume := forwardedResponseOrLocalVolume(s, r, poolName, volumeProjectName, volumeName, volumeType)
 	if resp != nil {
 		return resp
 	}
@@ -2642,19 +3139,36 @@ func storagePoolVolumeDelete(d *Daemon, r *http.Request) response.Response {
 		return response.SmartError(err)
 	}
 
-	// Get the storage volume.
-	var dbVolume *db.StorageVolume
-	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
-		dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), volumeProjectName, volumeType, volumeName, true)
-		return err
-	})
+	// Get the storage volume if it wasn't already loaded while looking up its location.
+	if dbVolume == nil {
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), volumeProjectName, volumeType, volumeName, true)
+			return err
+		})
+		if err != nil {
+			return response.SmartError(err)
+		}
+	}
+
+	// Use an empty operation for this sync response to pass the requestor
+	op := &operations.Operation{}
+	op.SetRequestor(r)
+
+	force := util.IsTrue(request.QueryParam(r, "force"))
+
+	err = storagePoolVolumeDeleteUnused(s, requestProjectName, pool, volumeProjectName, dbVolume, volumeType, force, op)
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	volumeUsedBy, err := storagePoolVolumeUsedByGet(s, requestProjectName, poolName, dbVolume)
+	return response.EmptySyncResponse
+}
+
+// storagePoolVolumeDeleteUnused deletes a custom or image volume, failing if it's still in use unless force is set.
+func storagePoolVolumeDeleteUnused(s *state.State, requestProjectName string, pool storagePools.Pool, volumeProjectName string, dbVolume *db.StorageVolume, volumeType int, force bool, op *operations.Operation) error {
+	volumeUsedBy, err := storagePoolVolumeUsedByGet(s, requestProjectName, pool.Name(), dbVolume)
 	if err != nil {
-		return response.SmartError(err)
+		return err
 	}
 
 	// isImageURL checks whether the provided usedByURL represents an image resource for the fingerprint.
@@ -2670,28 +3184,219 @@ func storagePoolVolumeDelete(d *Daemon, r *http.Request) response.Response {
 
 	if len(volumeUsedBy) > 0 {
 		if len(volumeUsedBy) != 1 || volumeType != db.StoragePoolVolumeTypeImage || !isImageURL(volumeUsedBy[0], dbVolume.Name) {
-			return response.BadRequest(errors.New("The storage volume is still in use"))
+			if !force {
+				return api.StatusErrorf(http.StatusBadRequest, "The storage volume is still in use")
+			}
+
+			logger.Warn("Forcing deletion of storage volume still in use", logger.Ctx{"pool": pool.Name(), "project": volumeProjectName, "volume": dbVolume.Name, "type": dbVolume.Type, "usedBy": volumeUsedBy})
 		}
 	}
 
-	// Use an empty operation for this sync response to pass the requestor
-	op := &operations.Operation{}
-	op.SetRequestor(r)
-
 	switch volumeType {
 	case db.StoragePoolVolumeTypeCustom:
-		err = pool.DeleteCustomVolume(volumeProjectName, volumeName, op)
+		return pool.DeleteCustomVolume(volumeProjectName, dbVolume.Name, op)
 	case db.StoragePoolVolumeTypeImage:
-		err = pool.DeleteImage(volumeName, op)
+		return pool.DeleteImage(dbVolume.Name, op)
 	default:
-		return response.BadRequest(fmt.Errorf(`Storage volumes of type %q cannot be deleted with the storage API`, volumeTypeName))
+		return api.StatusErrorf(http.StatusBadRequest, "Storage volumes of type %q cannot be deleted with the storage API", dbVolume.Type)
 	}
+}
 
+// swagger:operation POST /1.0/storage-pools/{poolName}/volumes/bulk-delete storage storage_pool_volumes_bulk_delete_post
+//
+//	Delete multiple storage volumes
+//
+//	Removes the listed custom and image storage volumes in a single operation.
+//	Volumes which can't be deleted (for example because they're still in use) are
+//	reported in the operation's "results" metadata without aborting the others.
+//
+//	---
+//	consumes:
+//	  - application/json
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: path
+//	    name: poolName
+//	    description: Storage pool name
+//	    type: string
+//	    required: true
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	  - in: body
+//	    name: volumes
+//	    description: Storage volumes to delete
+//	    required: true
+//	    schema:
+//	      $ref: "#/definitions/StorageVolumesBulkDeletePost"
+//	responses:
+//	  "202":
+//	    $ref: "#/responses/Operation"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
+func storagePoolVolumesBulkDeletePost(d *Daemon, r *http.Request) response.Response {
+	s := d.State()
+
+	poolName, err := pathVar(r, "poolName")
 	if err != nil {
 		return response.SmartError(err)
 	}
 
-	return response.EmptySyncResponse
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	req := api.StorageVolumesBulkDeletePost{}
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	if len(req.Volumes) == 0 {
+		return response.BadRequest(errors.New("No storage volumes provided"))
+	}
+
+	pool, err := storagePools.LoadByName(s, poolName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	requestProjectName := request.ProjectParam(r)
+
+	type bulkDeleteVolume struct {
+		key               string
+		volumeProjectName string
+		volumeType        int
+		dbVolume          *db.StorageVolume
+	}
+
+	// Resolve all entries and check permissions while the request is still available.
+	// Entries which fail here are recorded in the results and skipped by the operation.
+	results := make(map[string]string, len(req.Volumes))
+	volumes := make([]bulkDeleteVolume, 0, len(req.Volumes))
+	resources := map[string][]api.URL{}
+
+	for _, entry := range req.Volumes {
+		entryProjectName := entry.Project
+		if entryProjectName == "" {
+			entryProjectName = requestProjectName
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", entryProjectName, entry.Type, entry.Name)
+
+		_, ok := results[key]
+		if ok {
+			continue
+		}
+
+		if entry.Name == "" || internalInstance.IsSnapshot(entry.Name) {
+			results[key] = fmt.Sprintf("Invalid storage volume %q", entry.Name)
+			continue
+		}
+
+		if entry.Type != db.StoragePoolVolumeTypeNameCustom && entry.Type != db.StoragePoolVolumeTypeNameImage {
+			results[key] = fmt.Sprintf("Storage volumes of type %q cannot be deleted with the storage API", entry.Type)
+			continue
+		}
+
+		volumeType, err := storagePools.VolumeTypeNameToDBType(entry.Type)
+		if err != nil {
+			results[key] = err.Error()
+			continue
+		}
+
+		volumeProjectName, err := project.StorageVolumeProject(s.DB.Cluster, entryProjectName, volumeType)
+		if err != nil {
+			results[key] = err.Error()
+			continue
+		}
+
+		var dbVolume *db.StorageVolume
+		err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), volumeProjectName, volumeType, entry.Name, true)
+			return err
+		})
+		if err != nil {
+			results[key] = err.Error()
+			continue
+		}
+
+		var location string
+		if s.ServerClustered && !pool.Driver().Info().Remote {
+			location = dbVolume.Location
+		}
+
+		err = s.Authorizer.CheckPermission(r.Context(), r, auth.ObjectStorageVolume(volumeProjectName, poolName, entry.Type, entry.Name, location), auth.EntitlementCanEdit)
+		if err != nil {
+			results[key] = err.Error()
+			continue
+		}
+
+		results[key] = ""
+		volumes = append(volumes, bulkDeleteVolume{key: key, volumeProjectName: volumeProjectName, volumeType: volumeType, dbVolume: dbVolume})
+		resources["storage_volumes"] = append(resources["storage_volumes"], *api.NewURL().Path(version.APIVersion, "storage-pools", poolName, "volumes", entry.Type, entry.Name).Project(volumeProjectName))
+	}
+
+	run := func(op *operations.Operation) error {
+		for i, vol := range volumes {
+			_ = op.ExtendMetadata(map[string]any{"delete_progress": fmt.Sprintf("%d/%d", i+1, len(volumes))})
+
+			// A volume which can't be deleted only fails its own entry.
+			err := storagePoolVolumeDeleteUnused(s, requestProjectName, pool, vol.volumeProjectName, vol.dbVolume, vol.volumeType, false, op)
+			if err != nil {
+				results[vol.key] = err.Error()
+			}
+
+			_ = op.ExtendMetadata(map[string]any{"results": maps.Clone(results)})
+		}
+
+		var failed int
+		for _, result := range results {
+			if result != "" {
+				failed++
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("Failed deleting %d of %d storage volumes", failed, len(results))
+		}
+
+		return nil
+	}
+
+	op, err := operations.OperationCreate(s, requestProjectName, operations.OperationClassTask, operationtype.VolumeBulkDelete, resources, map[string]any{"results": maps.Clone(results)}, run, nil, nil, r)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	return operations.OperationResponse(op)
+}
+
+// checkUploadSpaceBudget checks the declared size of an upload against the project disk budget.
+// Uploads without a Content-Length are only checked while streaming.
+func checkUploadSpaceBudget(r *http.Request, budget int64) error {
+	if budget < 0 || r.ContentLength < 0 {
+		return nil
+	}
+
+	if r.ContentLength > budget {
+		return fmt.Errorf("Upload size %s exceeds the remaining project disk budget of %s", units.GetByteSizeString(r.ContentLength, 2), units.GetByteSizeString(budget, 2))
+	}
+
+	return nil
 }
 
 func createStoragePoolVolumeFromISO(s *state.State, r *http.Request, requestProjectName string, projectName string, data io.Reader, pool string, volName string) response.Response {
@@ -2734,6 +3439,12 @@ func createStoragePoolVolumeFromISO(s *state.State, r *http.Request, requestProj
 		return response.InternalError(err)
 	}
 
+	// Reject uploads that are declared larger than the project budget before streaming them.
+	err = checkUploadSpaceBudget(r, budget)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	// Stream uploaded ISO data into temporary file.
 	size, err := util.SafeCopy(internalIO.NewQuotaWriter(isoFile, budget), data)
 	if err != nil {
@@ -2802,6 +3513,12 @@ func createStoragePoolVolumeFromBackup(s *state.State, r *http.Request, requestP
 		return response.InternalError(err)
 	}
 
+	// Reject uploads that are declared larger than the project budget before streaming them.
+	err = checkUploadSpaceBudget(r, budget)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	// Stream uploaded backup data into temporary file.
 	_, err = util.SafeCopy(internalIO.NewQuotaWriter(backupFile, budget), data)
 	if err != nil {
@@ -2879,6 +3596,29 @@ func createStoragePoolVolumeFromBackup(s *state.State, r *http.Request, requestP
 		"snapshots": bInfo.Snapshots,
 	})
 
+	// Check that the optimized flag matches the content of squashfs converted backups.
+	if algo == ".squashfs" {
+		_, err = backupFile.Seek(0, io.SeekStart)
+		if err != nil {
+			return response.InternalError(err)
+		}
+
+		hasOptimizedData, err := backup.HasOptimizedData(backupFile, s.OS, backupFile.Name())
+		if err != nil {
+			return response.BadRequest(err)
+		}
+
+		if hasOptimizedData != *bInfo.OptimizedStorage {
+			logger.Warn("Backup optimized flag doesn't match its content", logger.Ctx{"name": bInfo.Name, "backend": bInfo.Backend, "optimized": *bInfo.OptimizedStorage})
+
+			if hasOptimizedData {
+				return response.BadRequest(fmt.Errorf("Backup contains optimized storage data but isn't marked as optimized, it can only be restored onto a %q storage pool", bInfo.Backend))
+			}
+
+			return response.BadRequest(fmt.Errorf("Backup is marked as optimized for the %q storage driver but contains no optimized storage data", bInfo.Backend))
+		}
+	}
+
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
 		// Check storage pool exists.
 		_, _, _, err = tx.GetStoragePoolInAnyState(ctx, bInfo.Pool)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"slices"
 	"strconv"
@@ -58,6 +59,10 @@ var storagePoolVolumeSnapshotTypeCmd = APIEndpoint{
 //	Create a storage volume snapshot
 //
 //	Creates a new storage volume snapshot.
+//	When action is set to "prune", the expired snapshots of the volume are deleted instead
+//	and the names of the deleted snapshots are returned in the operation metadata.
+//	When action is set to "preview-expiry", nothing is created and the expiry a snapshot
+//	created with the same request would get is returned as a StorageVolumeSnapshotExpiry.
 //
 //	---
 //	consumes:
@@ -90,6 +95,22 @@ var storagePoolVolumeSnapshotTypeCmd = APIEndpoint{
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: action
+//	    description: Set to "prune" to delete expired snapshots or "preview-expiry" to compute the expiry of a new snapshot
+//	    type: string
+//	    example: prune
+//	  - in: query
+//	    name: allow-past-expiry
+//	    description: Allow an expiry date in the past
+//	    type: boolean
+//	    example: true
+//	  - in: header
+//	    name: Idempotency-Key
+//	    description: Client supplied token, a retry with the same token returns the original operation
+//	    schema:
+//	      type: string
+//	    example: 9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d
 //	  - in: body
 //	    name: volume
 //	    description: Storage volume snapshot
@@ -143,6 +164,16 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 		return response.SmartError(err)
 	}
 
+	// Handle on-demand pruning of expired snapshots.
+	if request.QueryParam(r, "action") == "prune" {
+		return storagePoolVolumeSnapshotsPrune(s, r, poolName, projectName, volumeTypeName, volumeName, volumeType)
+	}
+
+	// Handle previewing the expiry of a new snapshot.
+	if request.QueryParam(r, "action") == "preview-expiry" {
+		return storagePoolVolumeSnapshotsPreviewExpiry(s, r, poolName, projectName, volumeName, volumeType)
+	}
+
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
 		dbProject, err := dbCluster.GetProject(context.Background(), tx.Tx(), projectName)
 		if err != nil {
@@ -165,6 +196,15 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 		return response.SmartError(err)
 	}
 
+	// On remote pools, the target selects which member takes the snapshot.
+	target := request.QueryParam(r, "target")
+	if s.ServerClustered && target != "" {
+		resp := storagePoolVolumeSnapshotCheckTarget(s, r, poolName, projectName, target)
+		if resp != nil {
+			return resp
+		}
+	}
+
 	// Forward if needed.
 	resp := forwardedResponseIfTargetIsRemote(s, r)
 	if resp != nil {
@@ -199,6 +239,23 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 		return response.SmartError(err)
 	}
 
+	// Replay the original response for a retried request.
+	idempotencyKey := r.Header.Get("Idempotency-Key")
+	if idempotencyKey != "" {
+		idempotencyKey = strings.Join([]string{poolName, projectName, volumeName, idempotencyKey}, "/")
+
+		prev, ok := storageVolumeSnapshotRequestGet(idempotencyKey)
+		if ok {
+			resp := storageVolumeSnapshotReplay(s, r, pool, projectName, volumeName, idempotencyKey, req, prev)
+			if resp != nil {
+				return resp
+			}
+		}
+	}
+
+	// Keep the request as sent to compare it with retries.
+	reqBody := req
+
 	// Get the parent volume.
 	var parentDBVolume *db.StorageVolume
 	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
@@ -218,9 +275,11 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 		return response.BadRequest(fmt.Errorf("Direct snapshots are not allowed for dependent volumes"))
 	}
 
-	// Get the snapshot pattern.
+	// Get the snapshot pattern, a pattern in the request only applies to this snapshot.
 	pattern := parentDBVolume.Config["snapshots.pattern"]
-	if pattern == "" {
+	if req.Pattern != "" {
+		pattern = req.Pattern
+	} else if pattern == "" {
 		pattern = "snap%d"
 	}
 
@@ -228,9 +287,17 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 		"creation_date": time.Now(),
 	})
 	if err != nil {
+		if req.Pattern != "" {
+			return response.BadRequest(fmt.Errorf("Invalid snapshot pattern: %w", err))
+		}
+
 		return response.InternalError(err)
 	}
 
+	if req.Pattern != "" && strings.Count(renderedPattern, "%d") > 1 {
+		return response.BadRequest(errors.New("Snapshot pattern may contain '%d' only once"))
+	}
+
 	// Get a snapshot name.
 	if req.Name == "" && strings.Count(renderedPattern, "%d") == 1 {
 		var i int
@@ -269,20 +336,21 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 		return response.BadRequest(fmt.Errorf("Invalid storage volume snapshot name: %w", err))
 	}
 
+	err = storageVolumeSnapshotNameValidate(req.Name, volumeName)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	// Fill in the expiry.
-	var expiry time.Time
-	if req.ExpiresAt != nil {
-		expiry = *req.ExpiresAt
-	} else {
-		duration := parentDBVolume.Config["snapshots.expiry.manual"]
-		if duration == "" {
-			duration = parentDBVolume.Config["snapshots.expiry"]
-		}
+	expiry, err := resolveVolumeSnapshotExpiry(time.Now(), parentDBVolume.Config, req.ExpiresAt, req.ExpiresIn)
+	if err != nil {
+		return response.BadRequest(err)
+	}
 
-		expiry, err = internalInstance.GetExpiry(time.Now(), duration)
-		if err != nil {
-			return response.BadRequest(err)
-		}
+	// Catch expiry dates which would get the snapshot removed on the next expiry run.
+	err = checkVolumeSnapshotPastExpiry(r, req.ExpiresAt, expiry, time.Now())
+	if err != nil {
+		return response.BadRequest(err)
 	}
 
 	// Create the snapshot.
@@ -294,14 +362,379 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 	resources["storage_volumes"] = []api.URL{*api.NewURL().Path(version.APIVersion, "storage-pools", poolName, "volumes", volumeTypeName, volumeName)}
 	resources["storage_volume_snapshots"] = []api.URL{*api.NewURL().Path(version.APIVersion, "storage-pools", poolName, "volumes", volumeTypeName, volumeName, "snapshots", req.Name)}
 
+	if idempotencyKey != "" {
+		// A concurrent retry may have got here first.
+		prev, ok := storageVolumeSnapshotRequestReserve(idempotencyKey, storageVolumeSnapshotRequest{
+			body:         reqBody,
+			snapshotName: req.Name,
+			resources:    resources,
+			created:      time.Now(),
+		})
+		if ok {
+			resp := storageVolumeSnapshotReplay(s, r, pool, projectName, volumeName, idempotencyKey, reqBody, prev)
+			if resp != nil {
+				return resp
+			}
+
+			return response.Conflict(errors.New("A request with the same Idempotency-Key is already in progress"))
+		}
+	}
+
 	op, err := operations.OperationCreate(s, request.ProjectParam(r), operations.OperationClassTask, operationtype.VolumeSnapshotCreate, resources, nil, snapshot, nil, nil, r)
 	if err != nil {
+		if idempotencyKey != "" {
+			storageVolumeSnapshotRequestForget(idempotencyKey, "")
+		}
+
 		return response.InternalError(err)
 	}
 
+	if idempotencyKey != "" {
+		storageVolumeSnapshotRequestSetOperation(idempotencyKey, op.ID())
+	}
+
 	return operations.OperationResponse(op)
 }
 
+// storageVolumeSnapshotIdempotencyWindow is how long a snapshot request can be retried with the same Idempotency-Key.
+const storageVolumeSnapshotIdempotencyWindow = 10 * time.Minute
+
+// storageVolumeSnapshotRequest records a snapshot creation request made with an Idempotency-Key.
+// The operationID is empty while the request is still being processed.
+type storageVolumeSnapshotRequest struct {
+	body         api.StorageVolumeSnapshotsPost
+	operationID  string
+	snapshotName string
+	resources    map[string][]api.URL
+	created      time.Time
+}
+
+// storageVolumeSnapshotRequests maps pool, project, volume and Idempotency-Key to the request they created.
+var storageVolumeSnapshotRequests = map[string]storageVolumeSnapshotRequest{}
+
+var storageVolumeSnapshotRequestsMu sync.Mutex
+
+// storageVolumeSnapshotRequestGet returns the earlier request made with the key, if any.
+func storageVolumeSnapshotRequestGet(key string) (storageVolumeSnapshotRequest, bool) {
+	storageVolumeSnapshotRequestsMu.Lock()
+	defer storageVolumeSnapshotRequestsMu.Unlock()
+
+	// Forget about requests outside of the retry window.
+	for k, req := range storageVolumeSnapshotRequests {
+		if time.Since(req.created) > storageVolumeSnapshotIdempotencyWindow {
+			delete(storageVolumeSnapshotRequests, k)
+		}
+	}
+
+	req, ok := storageVolumeSnapshotRequests[key]
+
+	return req, ok
+}
+
+// storageVolumeSnapshotRequestReserve records a new request for the key.
+// If there is already one, it's returned instead.
+func storageVolumeSnapshotRequestReserve(key string, req storageVolumeSnapshotRequest) (storageVolumeSnapshotRequest, bool) {
+	storageVolumeSnapshotRequestsMu.Lock()
+	defer storageVolumeSnapshotRequestsMu.Unlock()
+
+	prev, ok := storageVolumeSnapshotRequests[key]
+	if ok {
+		return prev, true
+	}
+
+	storageVolumeSnapshotRequests[key] = req
+
+	return storageVolumeSnapshotRequest{}, false
+}
+
+// storageVolumeSnapshotRequestSetOperation records the operation created by the request for the key.
+func storageVolumeSnapshotRequestSetOperation(key string, operationID string) {
+	storageVolumeSnapshotRequestsMu.Lock()
+	defer storageVolumeSnapshotRequestsMu.Unlock()
+
+	req, ok := storageVolumeSnapshotRequests[key]
+	if ok {
+		req.operationID = operationID
+		storageVolumeSnapshotRequests[key] = req
+	}
+}
+
+// storageVolumeSnapshotRequestForget removes the request for the key if it's still the one with the given operation.
+func storageVolumeSnapshotRequestForget(key string, operationID string) {
+	storageVolumeSnapshotRequestsMu.Lock()
+	defer storageVolumeSnapshotRequestsMu.Unlock()
+
+	req, ok := storageVolumeSnapshotRequests[key]
+	if ok && req.operationID == operationID {
+		delete(storageVolumeSnapshotRequests, key)
+	}
+}
+
+// storageVolumeSnapshotRequestMatches checks whether a retried request has the same body as the original one.
+func storageVolumeSnapshotRequestMatches(a api.StorageVolumeSnapshotsPost, b api.StorageVolumeSnapshotsPost) bool {
+	if a.Name != b.Name || a.ExpiresIn != b.ExpiresIn || a.Pattern != b.Pattern {
+		return false
+	}
+
+	if a.ExpiresAt == nil || b.ExpiresAt == nil {
+		return a.ExpiresAt == b.ExpiresAt
+	}
+
+	return a.ExpiresAt.Equal(*b.ExpiresAt)
+}
+
+// storageVolumeSnapshotReplay returns the response of the earlier request made with the key, or nil if it left nothing behind.
+func storageVolumeSnapshotReplay(s *state.State, r *http.Request, pool storagePools.Pool, projectName string, volumeName string, key string, body api.StorageVolumeSnapshotsPost, req storageVolumeSnapshotRequest) response.Response {
+	if !storageVolumeSnapshotRequestMatches(body, req.body) {
+		return response.SmartError(api.StatusErrorf(http.StatusUnprocessableEntity, "Idempotency-Key was already used for a different request"))
+	}
+
+	if req.operationID == "" {
+		return response.Conflict(errors.New("A request with the same Idempotency-Key is already in progress"))
+	}
+
+	op, err := operations.OperationGetInternal(req.operationID)
+	if err == nil {
+		_, apiOp, err := op.Render()
+		if err != nil {
+			return response.InternalError(err)
+		}
+
+		return operations.ForwardedOperationResponse(apiOp)
+	}
+
+	// The original operation is gone, so only report success if the snapshot exists.
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		_, err := tx.GetStoragePoolVolume(ctx, pool.ID(), projectName, db.StoragePoolVolumeTypeCustom, fmt.Sprintf("%s/%s", volumeName, req.snapshotName), true)
+		return err
+	})
+	if err != nil {
+		if response.IsNotFoundError(err) {
+			storageVolumeSnapshotRequestForget(key, req.operationID)
+			return nil
+		}
+
+		return response.SmartError(err)
+	}
+
+	done := func(op *operations.Operation) error {
+		return nil
+	}
+
+	op, err = operations.OperationCreate(s, request.ProjectParam(r), operations.OperationClassTask, operationtype.VolumeSnapshotCreate, req.resources, nil, done, nil, nil, r)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	return operations.OperationResponse(op)
+}
+
+// storagePoolVolumeSnapshotCheckTarget validates the cluster member selected to take a snapshot on a remote pool.
+func storagePoolVolumeSnapshotCheckTarget(s *state.State, r *http.Request, poolName string, projectName string, target string) response.Response {
+	pool, err := storagePools.LoadByName(s, poolName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	// Local pools are always forwarded to the member holding the volume.
+	if !pool.Driver().Info().Remote {
+		return nil
+	}
+
+	var targetMemberInfo *db.NodeInfo
+
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		p, err := dbCluster.GetProject(ctx, tx.Tx(), projectName)
+		if err != nil {
+			return err
+		}
+
+		targetProject, err := p.ToAPI(ctx, tx.Tx())
+		if err != nil {
+			return err
+		}
+
+		allMembers, err := tx.GetNodes(ctx)
+		if err != nil {
+			return fmt.Errorf("Failed getting cluster members: %w", err)
+		}
+
+		targetMemberInfo, _, err = project.CheckTarget(ctx, s.Authorizer, r, tx, targetProject, target, allMembers)
+		if err != nil {
+			return err
+		}
+
+		if targetMemberInfo == nil {
+			return fmt.Errorf("Failed checking cluster member %q", target)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	if targetMemberInfo.IsOffline(s.GlobalConfig.OfflineThreshold()) {
+		return response.BadRequest(errors.New("Target cluster member is offline"))
+	}
+
+	return nil
+}
+
+// storagePoolVolumeSnapshotsPrune deletes the expired snapshots of a single custom volume.
+func storagePoolVolumeSnapshotsPrune(s *state.State, r *http.Request, poolName string, projectName string, volumeTypeName string, volumeName string, volumeType int) response.Response {
+	// Forward if needed.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	resp = forwardedResponseIfVolumeIsRemote(s, r, poolName, projectName, volumeName, volumeType)
+	if resp != nil {
+		return resp
+	}
+
+	// Get the expired snapshots of the volume.
+	var expiredSnapshots []db.StorageVolumeArgs
+	err := s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		allExpiredSnapshots, err := tx.GetExpiredStorageVolumeSnapshots(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range allExpiredSnapshots {
+			parentName, _, _ := api.GetParentAndSnapshotName(v.Name)
+			if v.PoolName != poolName || v.ProjectName != projectName || parentName != volumeName {
+				continue
+			}
+
+			expiredSnapshots = append(expiredSnapshots, v)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	prune := func(op *operations.Operation) error {
+		deleted, err := pruneExpiredCustomVolumeSnapshots(context.TODO(), s, expiredSnapshots)
+
+		snapshotNames := make([]string, 0, len(deleted))
+		for _, name := range deleted {
+			_, snapName, _ := api.GetParentAndSnapshotName(name)
+			snapshotNames = append(snapshotNames, snapName)
+		}
+
+		metaErr := op.UpdateMetadata(map[string]any{"deleted_snapshots": snapshotNames})
+		if metaErr != nil {
+			logger.Warn("Failed updating prune operation metadata", logger.Ctx{"err": metaErr})
+		}
+
+		return err
+	}
+
+	resources := map[string][]api.URL{}
+	resources["storage_volumes"] = []api.URL{*api.NewURL().Path(version.APIVersion, "storage-pools", poolName, "volumes", volumeTypeName, volumeName)}
+
+	op, err := operations.OperationCreate(s, request.ProjectParam(r), operations.OperationClassTask, operationtype.CustomVolumeSnapshotsExpire, resources, nil, prune, nil, nil, r)
+	if err != nil {
+		return response.InternalError(err)
+	}
+
+	return operations.OperationResponse(op)
+}
+
+// resolveVolumeSnapshotExpiry returns the expiry of a new manual snapshot of a volume with the given config.
+// An expiry date or expression from the request takes precedence over the volume's expiry config.
+func resolveVolumeSnapshotExpiry(now time.Time, config map[string]string, expiresAt *time.Time, expiresIn string) (time.Time, error) {
+	if expiresAt != nil {
+		return *expiresAt, nil
+	}
+
+	if expiresIn != "" {
+		expiry, err := internalInstance.GetExpiry(now, expiresIn)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("Invalid snapshot expiry %q: %w", expiresIn, err)
+		}
+
+		return expiry, nil
+	}
+
+	return internalInstance.GetExpiry(now, snapshotExpiryDuration(config, true))
+}
+
+// checkVolumeSnapshotPastExpiry rejects a requested expiry date in the past unless allow-past-expiry is set.
+// Such a snapshot would get removed on the next expiry run.
+func checkVolumeSnapshotPastExpiry(r *http.Request, expiresAt *time.Time, expiry time.Time, now time.Time) error {
+	if expiresAt != nil && !expiry.IsZero() && expiry.Before(now) && !util.IsTrue(request.QueryParam(r, "allow-past-expiry")) {
+		return fmt.Errorf("Snapshot expiry %q is in the past", expiry.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// storagePoolVolumeSnapshotsPreviewExpiry returns the expiry a new snapshot of the volume would get.
+func storagePoolVolumeSnapshotsPreviewExpiry(s *state.State, r *http.Request, poolName string, projectName string, volumeName string, volumeType int) response.Response {
+	// Forward if needed.
+	resp := forwardedResponseIfTargetIsRemote(s, r)
+	if resp != nil {
+		return resp
+	}
+
+	resp = forwardedResponseIfVolumeIsRemote(s, r, poolName, projectName, volumeName, volumeType)
+	if resp != nil {
+		return resp
+	}
+
+	// Parse the request, an empty body previews the volume's default expiry.
+	req := api.StorageVolumeSnapshotsPost{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return response.BadRequest(err)
+	}
+
+	pool, err := storagePools.LoadByName(s, poolName)
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	var parentDBVolume *db.StorageVolume
+	err = s.DB.Cluster.Transaction(r.Context(), func(ctx context.Context, tx *db.ClusterTx) error {
+		parentDBVolume, err = tx.GetStoragePoolVolume(ctx, pool.ID(), projectName, volumeType, volumeName, true)
+
+		return err
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	now := time.Now()
+
+	expiry, err := resolveVolumeSnapshotExpiry(now, parentDBVolume.Config, req.ExpiresAt, req.ExpiresIn)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	err = checkVolumeSnapshotPastExpiry(r, req.ExpiresAt, expiry, now)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
+	entry := api.StorageVolumeSnapshotExpiry{
+		Name:   req.Name,
+		Expiry: "never",
+	}
+
+	if !expiry.IsZero() {
+		entry.Expiry = expiry.Format(time.RFC3339)
+		entry.ExpiresAt = &expiry
+		entry.PendingRemoval = expiry.Before(now)
+	}
+
+	return response.SyncResponse(true, entry)
+}
+
 // swagger:operation GET /1.0/storage-pools/{poolName}/volumes/{type}/{volumeName}/snapshots storage storage_pool_volumes_type_snapshots_get
 //
 //  Get the storage volume snapshots
@@ -366,6 +799,8 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 //                "/1.0/storage-pools/local/volumes/custom/foo/snapshots/snap0",
 //                "/1.0/storage-pools/local/volumes/custom/foo/snapshots/snap1"
 //              ]
+//    "304":
+//      description: Not modified (matching If-None-Match)
 //    "403":
 //      $ref: "#/responses/Forbidden"
 //    "500":
@@ -434,6 +869,73 @@ func storagePoolVolumeSnapshotsTypePost(d *Daemon, r *http.Request) response.Res
 //	    $ref: "#/responses/Forbidden"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
+
+// swagger:operation GET /1.0/storage-pools/{poolName}/volumes/{type}/{volumeName}/snapshots?expiry=1 storage storage_pool_volumes_type_snapshots_get_expiry
+//
+//	Get the storage volume snapshot expiries
+//
+//	Returns the computed expiry of each snapshot, whether it will be removed
+//	on the next run of the expiry task and which cluster member will remove it.
+//
+//	---
+//	produces:
+//	  - application/json
+//	parameters:
+//	  - in: path
+//	    name: poolName
+//	    description: Storage pool name
+//	    type: string
+//	    required: true
+//	  - in: path
+//	    name: type
+//	    description: Storage volume type
+//	    type: string
+//	    required: true
+//	  - in: path
+//	    name: volumeName
+//	    description: Storage volume name
+//	    type: string
+//	    required: true
+//	  - in: query
+//	    name: project
+//	    description: Project name
+//	    type: string
+//	    example: default
+//	  - in: query
+//	    name: target
+//	    description: Cluster member name
+//	    type: string
+//	    example: server01
+//	responses:
+//	  "200":
+//	    description: API endpoints
+//	    schema:
+//	      type: object
+//	      description: Sync response
+//	      properties:
+//	        type:
+//	          type: string
+//	          description: Response type
+//	          example: sync
+//	        status:
+//	          type: string
+//	          description: Status description
+//	          example: Success
+//	        status_code:
+//	          type: integer
+//	          description: Status code
+//	          example: 200
+//	        metadata:
+//	          type: array
+//	          description: List of storage volume snapshot expiries
+//	          items:
+//	            $ref: "#/definitions/StorageVolumeSnapshotExpiry"
+//	  "400":
+//	    $ref: "#/responses/BadRequest"
+//	  "403":
+//	    $ref: "#/responses/Forbidden"
+//	  "500":
+//	    $ref: "#/responses/InternalServerError"
 func storagePoolVolumeSnapshotsTypeGet(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
@@ -508,6 +1010,27 @@ func storagePoolVolumeSnapshotsTypeGet(d *Daemon, r *http.Request) response.Resp
 		return response.SmartError(err)
 	}
 
+	if util.IsTrue(request.QueryParam(r, "expiry")) {
+		return storagePoolVolumeSnapshotsExpiryGet(r.Context(), s, poolID, projectName, volumeName, volumeType, volumes)
+	}
+
+	// Compute the ETag from the snapshot names and expiry dates.
+	etag := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		etag = append(etag, fmt.Sprintf("%s/%d", volume.Name, volume.ExpiryDate.Unix()))
+	}
+
+	slices.Sort(etag)
+
+	err = localUtil.EtagCheck(r, etag)
+	if err != nil {
+		if api.StatusErrorCheck(err, http.StatusNotModified) {
+			return response.NotModified(etag)
+		}
+
+		return response.SmartError(err)
+	}
+
 	// Prepare the response.
 	resultString := []string{}
 	resultMap := []*api.StorageVolumeSnapshot{}
@@ -549,10 +1072,97 @@ func storagePoolVolumeSnapshotsTypeGet(d *Daemon, r *http.Request) response.Resp
 	}
 
 	if !recursion {
-		return response.SyncResponse(true, resultString)
+		return response.SyncResponseETag(true, resultString, etag)
 	}
 
-	return response.SyncResponse(true, resultMap)
+	return response.SyncResponseETag(true, resultMap, etag)
+}
+
+// storagePoolVolumeSnapshotsExpiryGet returns the expiry of each snapshot along with the cluster member
+// the expiry task will use to remove it, using the same member selection as the task itself.
+func storagePoolVolumeSnapshotsExpiryGet(ctx context.Context, s *state.State, poolID int64, projectName string, volumeName string, volumeType int, snapshots []db.StorageVolumeArgs) response.Response {
+	if volumeType != db.StoragePoolVolumeTypeCustom {
+		return response.BadRequest(errors.New("Snapshot expiry is only reported for custom volumes"))
+	}
+
+	now := time.Now()
+	result := make([]api.StorageVolumeSnapshotExpiry, 0, len(snapshots))
+
+	err := s.DB.Cluster.Transaction(ctx, func(ctx context.Context, tx *db.ClusterTx) error {
+		vol, err := tx.GetStoragePoolVolume(ctx, poolID, projectName, volumeType, volumeName, true)
+		if err != nil {
+			return err
+		}
+
+		var members []db.NodeInfo
+		var onlineMemberIDs []int64
+
+		// Snapshots of remote volumes are expired by a stable random online member.
+		if s.ServerClustered && vol.Location == "" {
+			members, err = tx.GetNodes(ctx)
+			if err != nil {
+				return fmt.Errorf("Failed getting cluster members: %w", err)
+			}
+
+			for _, member := range members {
+				if member.IsOffline(s.GlobalConfig.OfflineThreshold()) {
+					continue
+				}
+
+				onlineMemberIDs = append(onlineMemberIDs, member.ID)
+			}
+		}
+
+		for _, snap := range snapshots {
+			expiry, err := tx.GetStorageVolumeSnapshotExpiry(ctx, snap.ID)
+			if err != nil {
+				return err
+			}
+
+			_, snapName, _ := api.GetParentAndSnapshotName(snap.Name)
+
+			entry := api.StorageVolumeSnapshotExpiry{
+				Name:   snapName,
+				Expiry: "never",
+			}
+
+			if s.ServerClustered {
+				entry.Location = vol.Location
+				if vol.Location == "" && len(members) == 1 {
+					entry.Location = members[0].Name
+				} else if vol.Location == "" && len(onlineMemberIDs) > 0 {
+					selectedMemberID, err := localUtil.GetStableRandomInt64FromList(snap.ID, onlineMemberIDs)
+					if err != nil {
+						return err
+					}
+
+					for _, member := range members {
+						if member.ID == selectedMemberID {
+							entry.Location = member.Name
+							break
+						}
+					}
+				}
+			}
+
+			if expiry.Unix() > 0 {
+				entry.Expiry = expiry.Format(time.RFC3339)
+				entry.ExpiresAt = &expiry
+
+				// Nothing gets removed if no member could be selected.
+				entry.PendingRemoval = !expiry.After(now.Add(customVolumeSnapshotsTaskInterval(s))) && (!s.ServerClustered || entry.Location != "")
+			}
+
+			result = append(result, entry)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return response.SmartError(err)
+	}
+
+	return response.SyncResponse(true, result)
 }
 
 // swagger:operation POST /1.0/storage-pools/{poolName}/volumes/{type}/{volumeName}/snapshots/{snapshotName} storage storage_pool_volumes_type_snapshot_post
@@ -681,6 +1291,11 @@ func storagePoolVolumeSnapshotTypePost(d *Daemon, r *http.Request) response.Resp
 		return response.BadRequest(fmt.Errorf("Invalid storage volume snapshot name: %w", err))
 	}
 
+	err = storageVolumeSnapshotNameValidate(req.Name, volumeName)
+	if err != nil {
+		return response.BadRequest(err)
+	}
+
 	// This is a migration request so send back requested secrets.
 	if req.Migration {
 		req := api.StorageVolumePost{
@@ -1362,9 +1977,21 @@ func storagePoolVolumeSnapshotTypeDelete(d *Daemon, r *http.Request) response.Re
 	return operations.OperationResponse(op)
 }
 
+// customVolumeSnapshotsTaskInterval returns how often the custom volume snapshot expiry and creation task runs.
+func customVolumeSnapshotsTaskInterval(s *state.State) time.Duration {
+	return s.GlobalConfig.VolumeSnapshotsInterval()
+}
+
 func pruneExpiredAndAutoCreateCustomVolumeSnapshotsTask(d *Daemon) (task.Func, task.Schedule) {
+	// Track the previous run so that schedules falling between two runs aren't missed or repeated.
+	lastRun := time.Now()
+
 	f := func(ctx context.Context) {
 		s := d.State()
+		now := time.Now()
+		since := lastRun
+		lastRun = now
+
 		var volumes, remoteVolumes, expiredSnapshots, expiredRemoteSnapshots []db.StorageVolumeArgs
 		var memberCount int
 		var onlineMemberIDs []int64
@@ -1417,8 +2044,8 @@ func pruneExpiredAndAutoCreateCustomVolumeSnapshotsTask(d *Daemon) (task.Func, t
 					continue
 				}
 
-				// Check if snapshot is scheduled.
-				if !snapshotIsScheduledNow(schedule, v.ID) {
+				// Check if a snapshot was scheduled since the previous run.
+				if !snapshotIsScheduledSince(schedule, v.ID, since, now) {
 					continue
 				}
 
@@ -1527,7 +2154,8 @@ func pruneExpiredAndAutoCreateCustomVolumeSnapshotsTask(d *Daemon) (task.Func, t
 		// disk space.
 		if len(expiredSnapshots) > 0 {
 			opRun := func(op *operations.Operation) error {
-				return pruneExpiredCustomVolumeSnapshots(ctx, s, expiredSnapshots)
+				_, err := pruneExpiredCustomVolumeSnapshots(ctx, s, expiredSnapshots)
+				return err
 			}
 
 			op, err := operations.OperationCreate(s, "", operations.OperationClassTask, operationtype.CustomVolumeSnapshotsExpire, nil, nil, opRun, nil, nil, nil)
@@ -1577,7 +2205,8 @@ func pruneExpiredAndAutoCreateCustomVolumeSnapshotsTask(d *Daemon) (task.Func, t
 
 	first := true
 	schedule := func() (time.Duration, error) {
-		interval := time.Minute
+		// Re-read the interval on every tick so configuration changes apply without a restart.
+		interval := customVolumeSnapshotsTaskInterval(d.State())
 
 		if first {
 			first = false
@@ -1592,11 +2221,14 @@ func pruneExpiredAndAutoCreateCustomVolumeSnapshotsTask(d *Daemon) (task.Func, t
 
 var customVolSnapshotsPruneRunning = sync.Map{}
 
-func pruneExpiredCustomVolumeSnapshots(ctx context.Context, s *state.State, expiredSnapshots []db.StorageVolumeArgs) error {
+// pruneExpiredCustomVolumeSnapshots deletes the given snapshots and returns the names of those it deleted.
+func pruneExpiredCustomVolumeSnapshots(ctx context.Context, s *state.State, expiredSnapshots []db.StorageVolumeArgs) ([]string, error) {
+	deleted := []string{}
+
 	for _, v := range expiredSnapshots {
 		err := ctx.Err()
 		if err != nil {
-			return err // Stop if context is cancelled.
+			return deleted, err // Stop if context is cancelled.
 		}
 
 		_, loaded := customVolSnapshotsPruneRunning.LoadOrStore(v.ID, struct{}{})
@@ -1607,33 +2239,50 @@ func pruneExpiredCustomVolumeSnapshots(ctx context.Context, s *state.State, expi
 		pool, err := storagePools.LoadByName(s, v.PoolName)
 		if err != nil {
 			customVolSnapshotsPruneRunning.Delete(v.ID)
-			return fmt.Errorf("Error loading pool for volume snapshot %q (project %q, pool %q): %w", v.Name, v.ProjectName, v.PoolName, err)
+			return deleted, fmt.Errorf("Error loading pool for volume snapshot %q (project %q, pool %q): %w", v.Name, v.ProjectName, v.PoolName, err)
 		}
 
 		err = pool.DeleteCustomVolumeSnapshot(v.ProjectName, v.Name, nil)
 		customVolSnapshotsPruneRunning.Delete(v.ID)
 		if err != nil {
-			return fmt.Errorf("Error deleting custom volume snapshot %q (project %q, pool %q): %w", v.Name, v.ProjectName, v.PoolName, err)
+			return deleted, fmt.Errorf("Error deleting custom volume snapshot %q (project %q, pool %q): %w", v.Name, v.ProjectName, v.PoolName, err)
 		}
+
+		deleted = append(deleted, v.Name)
 	}
 
-	return nil
+	return deleted, nil
 }
 
 func autoCreateCustomVolumeSnapshots(ctx context.Context, s *state.State, volumes []db.StorageVolumeArgs) error {
-	// Make the snapshots sequentially.
-	for _, v := range volumes {
+	// Make the snapshots sequentially, optionally spaced out to avoid an I/O burst.
+	delay := s.GlobalConfig.VolumeSnapshotsDelay()
+
+	// Keep the total delay within the task interval so runs don't pile up.
+	if len(volumes) > 1 {
+		delay = min(delay, s.GlobalConfig.VolumeSnapshotsInterval()/time.Duration(len(volumes)-1))
+	}
+
+	for i, v := range volumes {
 		err := ctx.Err()
 		if err != nil {
 			return err // Stop if context is cancelled.
 		}
 
+		if i > 0 && delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
 		snapshotName, err := volumeDetermineNextSnapshotName(ctx, s, v, "snap%d")
 		if err != nil {
 			return fmt.Errorf("Error retrieving next snapshot name for volume %q (project %q, pool %q): %w", v.Name, v.ProjectName, v.PoolName, err)
 		}
 
-		expiry, err := internalInstance.GetExpiry(time.Now(), v.Config["snapshots.expiry"])
+		expiry, err := internalInstance.GetExpiry(time.Now(), snapshotExpiryDuration(v.Config, false))
 		if err != nil {
 			return fmt.Errorf("Error getting snapshot expiry for volume %q (project %q, pool %q): %w", v.Name, v.ProjectName, v.PoolName, err)
 		}
@@ -1652,6 +2301,26 @@ func autoCreateCustomVolumeSnapshots(ctx context.Context, s *state.State, volume
 	return nil
 }
 
+// storageVolumeReservedSnapshotNames are the names which clash with the volume sub-resource URLs.
+var storageVolumeReservedSnapshotNames = []string{"backups", "bitmaps", "files", "nbd", "rebuild", "sftp", "snapshots", "state"}
+
+// storageVolumeSnapshotNameValidate rejects snapshot names that are reserved or match the parent volume name.
+func storageVolumeSnapshotNameValidate(snapshotName string, volumeName string) error {
+	if snapshotName == "" {
+		return errors.New("Snapshot name cannot be empty")
+	}
+
+	if slices.Contains(storageVolumeReservedSnapshotNames, snapshotName) {
+		return fmt.Errorf("Snapshot name %q is reserved (reserved names: %s)", snapshotName, strings.Join(storageVolumeReservedSnapshotNames, ", "))
+	}
+
+	if snapshotName == volumeName {
+		return fmt.Errorf("Snapshot name %q cannot be the same as the volume name", snapshotName)
+	}
+
+	return nil
+}
+
 func volumeDetermineNextSnapshotName(ctx context.Context, s *state.State, volume db.StorageVolumeArgs, defaultPattern string) (string, error) {
 	var err error
 
@@ -1753,5 +2422,10 @@ func volumeDetermineNextSnapshotName(ctx context.Context, s *state.State, volume
 		return "", errors.New("Snapshot with that name already exists")
 	}
 
+	err = storageVolumeSnapshotNameValidate(pattern, volume.Name)
+	if err != nil {
+		return "", err
+	}
+
 	return pattern, nil
 }
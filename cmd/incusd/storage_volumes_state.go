@@ -15,6 +15,7 @@ import (
 	storagePools "github.com/lxc/incus/v7/internal/server/storage"
 	storageDrivers "github.com/lxc/incus/v7/internal/server/storage/drivers"
 	"github.com/lxc/incus/v7/shared/api"
+	"github.com/lxc/incus/v7/shared/util"
 )
 
 var storagePoolVolumeTypeStateCmd = APIEndpoint{
@@ -58,6 +59,11 @@ var storagePoolVolumeTypeStateCmd = APIEndpoint{
 //	    description: Cluster member name
 //	    type: string
 //	    example: server01
+//	  - in: query
+//	    name: strict
+//	    description: Fail with 501 rather than returning an empty state when the driver can't report usage
+//	    type: boolean
+//	    example: true
 //	responses:
 //	  "200":
 //	    description: Storage pool
@@ -83,6 +89,8 @@ var storagePoolVolumeTypeStateCmd = APIEndpoint{
 //	    $ref: "#/responses/Forbidden"
 //	  "500":
 //	    $ref: "#/responses/InternalServerError"
+//	  "501":
+//	    $ref: "#/responses/NotImplemented"
 func storagePoolVolumeTypeStateGet(d *Daemon, r *http.Request) response.Response {
 	s := d.State()
 
@@ -130,11 +138,26 @@ func storagePoolVolumeTypeStateGet(d *Daemon, r *http.Request) response.Response
 	// Fetch the current usage.
 	var usage *storagePools.VolumeUsage
 	if volumeType == db.StoragePoolVolumeTypeCustom {
+		resp := forwardedResponseIfTargetIsRemote(s, r)
+		if resp != nil {
+			return resp
+		}
+
+		resp = forwardedResponseIfVolumeIsRemote(s, r, poolName, projectName, volumeName, volumeType)
+		if resp != nil {
+			return resp
+		}
+
 		// Custom volumes.
 		usage, err = pool.GetCustomVolumeUsage(projectName, volumeName)
 		if err != nil && !errors.Is(err, storageDrivers.ErrNotSupported) {
 			return response.SmartError(err)
 		}
+
+		// Only callers asking for it get an error, others keep getting an empty state.
+		if err != nil && util.IsTrue(r.FormValue("strict")) {
+			return response.NotImplemented(fmt.Errorf("Storage pool %q (driver %q) can't report volume usage", pool.Name(), pool.Driver().Info().Name))
+		}
 	} else {
 		resp, err := forwardedResponseIfInstanceIsRemote(s, r, projectName, volumeName)
 		if err != nil {
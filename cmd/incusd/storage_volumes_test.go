@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lxc/incus/v7/internal/migration"
+	"github.com/lxc/incus/v7/internal/server/db"
+	"github.com/lxc/incus/v7/internal/server/db/operationtype"
+	"github.com/lxc/incus/v7/internal/server/operations"
+	"github.com/lxc/incus/v7/internal/server/response"
+	storagePools "github.com/lxc/incus/v7/internal/server/storage"
+	storageDrivers "github.com/lxc/incus/v7/internal/server/storage/drivers"
+	"github.com/lxc/incus/v7/shared/api"
+)
+
+type storageVolumesTestSuite struct {
+	daemonTestSuite
+}
+
+// Check that a failed destination leaves the source volume, its snapshots and records in place.
+func (s *storageVolumesTestSuite) TestStorageVolumeMigrateAbortSource() {
+	st := s.d.State()
+
+	pool, err := storagePools.LoadByName(st, daemonTestSuiteDefaultStoragePool)
+	s.Req.NoError(err)
+
+	s.Req.NoError(pool.CreateCustomVolume(api.ProjectDefaultName, "vol", "", nil, storageDrivers.ContentTypeFS, nil))
+	s.Req.NoError(pool.CreateCustomVolumeSnapshot(api.ProjectDefaultName, "vol", "snap0", time.Time{}, false, nil))
+
+	// Destination member that rejects the volume create request.
+	dest := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = response.InternalError(errors.New("Destination failure")).Render(w, r)
+	}))
+
+	dest.TLS = &tls.Config{Certificates: []tls.Certificate{st.Endpoints.NetworkCert().KeyPair()}}
+	dest.StartTLS()
+	defer dest.Close()
+
+	srcMember := db.NodeInfo{Name: "source", Address: "127.0.0.1:8443", Heartbeat: time.Now()}
+	newMember := db.NodeInfo{Name: "destination", Address: dest.Listener.Addr().String(), Heartbeat: time.Now()}
+
+	run, err := storageVolumePostClusteringMigrate(st, nil, pool, api.ProjectDefaultName, "vol", pool.Name(), api.ProjectDefaultName, "", srcMember, newMember, false)
+	s.Req.NoError(err)
+
+	err = run(nil)
+	s.Req.ErrorContains(err, "Failed requesting volume create on destination")
+
+	// Wait for the source side to stop.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, op := range operations.Clone() {
+		if op.Type() != operationtype.VolumeMigrate {
+			continue
+		}
+
+		_ = op.Wait(ctx)
+		s.Req.NoError(ctx.Err())
+		s.NotEqual(api.Success, op.Status())
+	}
+
+	_, err = storagePools.VolumeDBGet(pool, api.ProjectDefaultName, "vol", storageDrivers.VolumeTypeCustom)
+	s.Req.NoError(err)
+
+	snapshots, err := storagePools.VolumeDBSnapshotsGet(pool, api.ProjectDefaultName, "vol", storageDrivers.VolumeTypeCustom)
+	s.Req.NoError(err)
+	s.Req.Len(snapshots, 1)
+	s.Equal("vol/snap0", snapshots[0].Name)
+}
+
+func TestResolveVolumeSnapshotExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	both := map[string]string{"snapshots.expiry": "1d", "snapshots.expiry.manual": "2w"}
+	auto := map[string]string{"snapshots.expiry": "1d"}
+	manual := map[string]string{"snapshots.expiry.manual": "2w"}
+
+	tests := []struct {
+		name      string
+		config    map[string]string
+		expiresAt *time.Time
+		expiresIn string
+		expected  time.Time
+	}{
+		{"Both keys set", both, nil, "", now.AddDate(0, 0, 14)},
+		{"Only snapshots.expiry set", auto, nil, "", now.AddDate(0, 0, 1)},
+		{"Only snapshots.expiry.manual set", manual, nil, "", now.AddDate(0, 0, 14)},
+		{"Neither key set", map[string]string{}, nil, "", time.Time{}},
+		{"Request date overrides config", both, &at, "", at},
+		{"Request expression overrides config", both, nil, "3H", now.Add(3 * time.Hour)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expiry, err := resolveVolumeSnapshotExpiry(now, test.config, test.expiresAt, test.expiresIn)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !expiry.Equal(test.expected) {
+				t.Fatalf("Unexpected expiry: got %v, expected %v", expiry, test.expected)
+			}
+		})
+	}
+
+	_, err := resolveVolumeSnapshotExpiry(now, both, nil, "soon")
+	if err == nil {
+		t.Fatal("Expected an error for an invalid expiry expression")
+	}
+}
+
+func TestStoragePoolVolumeDeviceSource(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{"vol1", "vol2"},
+		{"vol1/snap0", "vol2/snap0"},
+		{"vol1/", "vol2"},
+		{"other", "other"},
+		{"other/snap0", "other/snap0"},
+	}
+
+	for _, test := range tests {
+		source := storagePoolVolumeDeviceSource(test.source, "vol1", "vol2")
+		if source != test.expected {
+			t.Fatalf("Unexpected source for %q: got %q, expected %q", test.source, source, test.expected)
+		}
+	}
+}
+
+func TestStorageVolumeMigrationResume(t *testing.T) {
+	if storageVolumeMigrationResumable(map[string]string{}) {
+		t.Fatal("A volume without the partial marker shouldn't be resumable")
+	}
+
+	if !storageVolumeMigrationResumable(map[string]string{storagePools.VolumeMigrationPartialKey: "true"}) {
+		t.Fatal("A volume with the partial marker should be resumable")
+	}
+
+	sourceSnapshots := []*migration.Snapshot{{Name: proto.String("snap0")}, {Name: proto.String("snap1")}, {Name: proto.String("snap2")}}
+
+	resumed := storageVolumeMigrationResumedSnapshots(sourceSnapshots, []string{"snap2"})
+	if !slices.Equal(resumed, []string{"snap0", "snap1"}) {
+		t.Fatalf("Unexpected resumed snapshots: %v", resumed)
+	}
+
+	resumed = storageVolumeMigrationResumedSnapshots(sourceSnapshots, []string{"snap0", "snap1", "snap2"})
+	if len(resumed) != 0 {
+		t.Fatalf("Unexpected resumed snapshots: %v", resumed)
+	}
+}
+
+func TestStorageVolumeSnapshotRequestMatches(t *testing.T) {
+	at := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	atLocal := at.In(time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		name     string
+		retry    api.StorageVolumeSnapshotsPost
+		expected bool
+	}{
+		{"Same request", api.StorageVolumeSnapshotsPost{Name: "snap0", ExpiresAt: &at}, true},
+		{"Same expiry in another zone", api.StorageVolumeSnapshotsPost{Name: "snap0", ExpiresAt: &atLocal}, true},
+		{"Different name", api.StorageVolumeSnapshotsPost{Name: "snap1", ExpiresAt: &at}, false},
+		{"Missing expiry", api.StorageVolumeSnapshotsPost{Name: "snap0"}, false},
+	}
+
+	original := api.StorageVolumeSnapshotsPost{Name: "snap0", ExpiresAt: &at}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if storageVolumeSnapshotRequestMatches(test.retry, original) != test.expected {
+				t.Fatalf("Expected match to be %v", test.expected)
+			}
+		})
+	}
+}
+
+func TestStorageVolumes(t *testing.T) {
+	suite.Run(t, &storageVolumesTestSuite{})
+}
@@ -2,16 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"slices"
 	"strings"
 
+	"golang.org/x/sys/unix"
+
 	"github.com/lxc/incus/v7/internal/server/backup"
 	"github.com/lxc/incus/v7/internal/server/db"
+	dbCluster "github.com/lxc/incus/v7/internal/server/db/cluster"
 	"github.com/lxc/incus/v7/internal/server/instance"
+	"github.com/lxc/incus/v7/internal/server/project"
 	"github.com/lxc/incus/v7/internal/server/state"
 	storagePools "github.com/lxc/incus/v7/internal/server/storage"
+	storageDrivers "github.com/lxc/incus/v7/internal/server/storage/drivers"
 	"github.com/lxc/incus/v7/internal/version"
 	"github.com/lxc/incus/v7/shared/api"
+	"github.com/lxc/incus/v7/shared/units"
 )
 
 var supportedVolumeTypes = []int{db.StoragePoolVolumeTypeContainer, db.StoragePoolVolumeTypeVM, db.StoragePoolVolumeTypeCustom, db.StoragePoolVolumeTypeImage}
@@ -29,10 +37,7 @@ func storagePoolVolumeUpdateUsers(ctx context.Context, s *state.State, projectNa
 			_, exists := localDevices[devName]
 			if exists {
 				localDevices[devName]["pool"] = newPoolName
-
-				volFields := strings.SplitN(localDevices[devName]["source"], "/", 2)
-				volFields[0] = newVol.Name
-				localDevices[devName]["source"] = strings.Join(volFields, "/")
+				localDevices[devName]["source"] = storagePoolVolumeDeviceSource(localDevices[devName]["source"], oldVol.Name, newVol.Name)
 			}
 		}
 
@@ -64,10 +69,7 @@ func storagePoolVolumeUpdateUsers(ctx context.Context, s *state.State, projectNa
 		for name, dev := range profile.Devices {
 			if slices.Contains(usedByDevices, name) {
 				dev["pool"] = newPoolName
-
-				volFields := strings.SplitN(dev["source"], "/", 2)
-				volFields[0] = newVol.Name
-				dev["source"] = strings.Join(volFields, "/")
+				dev["source"] = storagePoolVolumeDeviceSource(dev["source"], oldVol.Name, newVol.Name)
 			}
 		}
 
@@ -89,6 +91,21 @@ func storagePoolVolumeUpdateUsers(ctx context.Context, s *state.State, projectNa
 	return nil
 }
 
+// storagePoolVolumeDeviceSource returns a disk device source pointing at the new volume name.
+// Snapshot qualified sources ("volume/snapshot") keep referencing the same snapshot and sources for other volumes are left as is.
+func storagePoolVolumeDeviceSource(source string, oldVolName string, newVolName string) string {
+	parentName, snapName, isSnap := api.GetParentAndSnapshotName(source)
+	if parentName != oldVolName {
+		return source
+	}
+
+	if isSnap && snapName != "" {
+		return newVolName + "/" + snapName
+	}
+
+	return newVolName
+}
+
 // storagePoolVolumeUsedByGet returns a list of URL resources that use the volume.
 func storagePoolVolumeUsedByGet(s *state.State, requestProjectName string, poolName string, vol *db.StorageVolume) ([]string, error) {
 	// Handle instance volumes.
@@ -140,6 +157,26 @@ func storagePoolVolumeUsedByGet(s *state.State, requestProjectName string, poolN
 	return volumeUsedBy, nil
 }
 
+// storagePoolVolumeUsedByFilterType returns the used-by entries whose URL matches the given entity type.
+func storagePoolVolumeUsedByFilterType(usedBy []string, entityTypeName string) ([]string, error) {
+	entityType, ok := dbCluster.EntityTypes[entityTypeName]
+	if !ok {
+		return nil, fmt.Errorf("Unknown entity type %q", entityTypeName)
+	}
+
+	filtered := []string{}
+	for _, entry := range usedBy {
+		entryType, _, _, _, err := dbCluster.URLToEntityType(entry)
+		if err != nil || entryType != entityType {
+			continue
+		}
+
+		filtered = append(filtered, entry)
+	}
+
+	return filtered, nil
+}
+
 func storagePoolVolumeBackupLoadByName(ctx context.Context, s *state.State, projectName, poolName, backupName string) (*backup.VolumeBackup, error) {
 	var b db.StoragePoolVolumeBackup
 
@@ -157,3 +194,65 @@ func storagePoolVolumeBackupLoadByName(ctx context.Context, s *state.State, proj
 
 	return volBackup, nil
 }
+
+// storagePoolVolumeValidateConfig checks a custom volume config against the pool driver's rules.
+func storagePoolVolumeValidateConfig(pool storagePools.Pool, projectName string, dbVolume *db.StorageVolume, config map[string]string) error {
+	dbContentType, err := storagePools.VolumeContentTypeNameToContentType(dbVolume.ContentType)
+	if err != nil {
+		return err
+	}
+
+	contentType, err := storagePools.VolumeDBContentTypeToContentType(dbContentType)
+	if err != nil {
+		return err
+	}
+
+	vol := pool.GetVolume(storageDrivers.VolumeTypeCustom, contentType, project.StorageVolume(projectName, dbVolume.Name), config)
+
+	return pool.Driver().ValidateVolume(vol, false)
+}
+
+// storagePoolVolumeValidateCreate checks that the pool can create a new volume of the given content type with
+// the given config, so bad requests don't surface as driver errors. Defaults such as the block volume size are
+// left to the driver and not stored in the volume config.
+func storagePoolVolumeValidateCreate(pool storagePools.Pool, projectName string, volName string, contentType storageDrivers.ContentType, config map[string]string) error {
+	info := pool.Driver().Info()
+
+	// Block and ISO volumes need a driver that can hold VM disks.
+	if storageDrivers.IsContentBlock(contentType) && !slices.Contains(info.VolumeTypes, storageDrivers.VolumeTypeVM) {
+		return fmt.Errorf("Storage pool %q (driver %q) doesn't support %q volumes", pool.Name(), info.Name, contentType)
+	}
+
+	// Fully allocated block volumes shouldn't silently take the default size.
+	if contentType == storageDrivers.ContentTypeBlock && info.ThickBlockVolumes && config["size"] == "" && pool.Driver().Config()["volume.size"] == "" {
+		return fmt.Errorf("Storage pool %q (driver %q) requires a size for %q volumes", pool.Name(), info.Name, contentType)
+	}
+
+	vol := pool.GetVolume(storageDrivers.VolumeTypeCustom, contentType, project.StorageVolume(projectName, volName), config)
+
+	err := pool.Driver().ValidateVolume(vol, false)
+	if err != nil {
+		return fmt.Errorf("Invalid %q volume config: %w", contentType, err)
+	}
+
+	return nil
+}
+
+// storagePoolVolumeSpaceError adds the pool's disk usage to volume creation errors caused by a lack of space.
+func storagePoolVolumeSpaceError(pool storagePools.Pool, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := strings.ToLower(err.Error())
+	if !errors.Is(err, unix.ENOSPC) && !strings.Contains(msg, "no space left") && !strings.Contains(msg, "out of space") && !strings.Contains(msg, "insufficient free space") {
+		return err
+	}
+
+	res, resErr := pool.GetResources()
+	if resErr != nil {
+		return err
+	}
+
+	return fmt.Errorf("%w (storage pool %q is using %s out of %s)", err, pool.Name(), units.GetByteSizeStringIEC(int64(res.Space.Used), 2), units.GetByteSizeStringIEC(int64(res.Space.Total), 2))
+}
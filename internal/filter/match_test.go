@@ -66,11 +66,14 @@ func TestMatch_Image(t *testing.T) {
 			},
 		},
 		Architecture: "i686",
+		Size:         4096,
 	}
 
 	cases := map[string]any{
 		"properties.os eq Ubuntu": true,
 		"architecture eq x86_64":  false,
+		"size gt 1024":            true,
+		"size le 1024":            false,
 	}
 
 	for s := range cases {
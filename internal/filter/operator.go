@@ -28,7 +28,13 @@ func QueryOperatorSet() OperatorSet {
 		Or:        "or",
 		Equals:    "eq",
 		NotEquals: "ne",
-		Negate:    "not",
-		Quote:     []string{"\""},
+
+		GreaterThan:  "gt",
+		LessThan:     "lt",
+		GreaterEqual: "ge",
+		LessEqual:    "le",
+
+		Negate: "not",
+		Quote:  []string{"\""},
 	}
 }
@@ -0,0 +1,49 @@
+package io
+
+import (
+	"hash"
+	"io"
+)
+
+// HashReadWriteCloser feeds the data read from and written to a ReadWriteCloser into hashes.
+type HashReadWriteCloser struct {
+	rwc       io.ReadWriteCloser
+	readHash  hash.Hash
+	writeHash hash.Hash
+}
+
+// NewHashReadWriteCloser returns a new HashReadWriteCloser wrapping the given ReadWriteCloser.
+//
+// Either hash can be nil, in which case that direction isn't hashed.
+func NewHashReadWriteCloser(rwc io.ReadWriteCloser, readHash hash.Hash, writeHash hash.Hash) *HashReadWriteCloser {
+	return &HashReadWriteCloser{
+		rwc:       rwc,
+		readHash:  readHash,
+		writeHash: writeHash,
+	}
+}
+
+// Read implements the Reader interface.
+func (h *HashReadWriteCloser) Read(p []byte) (int, error) {
+	n, err := h.rwc.Read(p)
+	if h.readHash != nil && n > 0 {
+		_, _ = h.readHash.Write(p[:n])
+	}
+
+	return n, err
+}
+
+// Write implements the Writer interface.
+func (h *HashReadWriteCloser) Write(p []byte) (int, error) {
+	n, err := h.rwc.Write(p)
+	if h.writeHash != nil && n > 0 {
+		_, _ = h.writeHash.Write(p[:n])
+	}
+
+	return n, err
+}
+
+// Close implements the Closer interface.
+func (h *HashReadWriteCloser) Close() error {
+	return h.rwc.Close()
+}
@@ -0,0 +1,88 @@
+package io
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// RateLimitedReadWriteCloser limits the throughput of reads and writes to a given number of bytes per second.
+type RateLimitedReadWriteCloser struct {
+	rwc   io.ReadWriteCloser
+	limit int64
+
+	mu    sync.Mutex
+	start time.Time
+	n     int64
+}
+
+// NewRateLimitedReadWriteCloser returns a new RateLimitedReadWriteCloser wrapping the given ReadWriteCloser.
+//
+// If the given limit isn't positive, then the ReadWriteCloser is returned unchanged.
+func NewRateLimitedReadWriteCloser(rwc io.ReadWriteCloser, limit int64) io.ReadWriteCloser {
+	if limit <= 0 {
+		return rwc
+	}
+
+	return &RateLimitedReadWriteCloser{
+		rwc:   rwc,
+		limit: limit,
+	}
+}
+
+// wait accounts for n transferred bytes and sleeps until the transfer is back under the limit.
+func (l *RateLimitedReadWriteCloser) wait(n int) {
+	l.mu.Lock()
+	if l.start.IsZero() {
+		l.start = time.Now()
+	}
+
+	l.n += int64(n)
+	delay := time.Duration(l.n*int64(time.Second)/l.limit) - time.Since(l.start)
+	l.mu.Unlock()
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// chunk returns the largest slice of p that can be transferred in one go.
+func (l *RateLimitedReadWriteCloser) chunk(p []byte) []byte {
+	if int64(len(p)) > l.limit {
+		return p[:l.limit]
+	}
+
+	return p
+}
+
+// Read implements the Reader interface.
+func (l *RateLimitedReadWriteCloser) Read(p []byte) (int, error) {
+	n, err := l.rwc.Read(l.chunk(p))
+	l.wait(n)
+
+	return n, err
+}
+
+// Write implements the Writer interface.
+func (l *RateLimitedReadWriteCloser) Write(p []byte) (int, error) {
+	total := 0
+	for len(p) > 0 {
+		buf := l.chunk(p)
+
+		n, err := l.rwc.Write(buf)
+		total += n
+		l.wait(n)
+		if err != nil {
+			return total, err
+		}
+
+		p = p[n:]
+	}
+
+	return total, nil
+}
+
+// Close implements the Closer interface.
+func (l *RateLimitedReadWriteCloser) Close() error {
+	return l.rwc.Close()
+}
@@ -768,6 +768,7 @@ type MigrationHeader struct {
 	BtrfsFeatures      *BtrfsFeatures         `protobuf:"bytes,12,opt,name=btrfsFeatures" json:"btrfsFeatures,omitempty"`
 	IndexHeaderVersion *uint32                `protobuf:"varint,13,opt,name=indexHeaderVersion" json:"indexHeaderVersion,omitempty"`
 	DependentVolumes   []*DependentVolume     `protobuf:"bytes,14,rep,name=dependentVolumes" json:"dependentVolumes,omitempty"`
+	VerifyTransfer     *bool                  `protobuf:"varint,15,opt,name=verifyTransfer" json:"verifyTransfer,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -893,6 +894,13 @@ func (x *MigrationHeader) GetDependentVolumes() []*DependentVolume {
 	return nil
 }
 
+func (x *MigrationHeader) GetVerifyTransfer() bool {
+	if x != nil && x.VerifyTransfer != nil {
+		return *x.VerifyTransfer
+	}
+	return false
+}
+
 type MigrationControl struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
 	Success *bool                  `protobuf:"varint,1,req,name=success" json:"success,omitempty"`
@@ -1048,7 +1056,7 @@ const file_internal_migration_migrate_proto_rawDesc = "" +
 	"\n" +
 	"deviceName\x18\n" +
 	" \x01(\tR\n" +
-	"deviceName\"\xf1\x04\n" +
+	"deviceName\"\x99\x05\n" +
 	"\x0fMigrationHeader\x12*\n" +
 	"\x02fs\x18\x01 \x02(\x0e2\x1a.migration.MigrationFSTypeR\x02fs\x12'\n" +
 	"\x04criu\x18\x02 \x01(\x0e2\x13.migration.CRIUTypeR\x04criu\x12*\n" +
@@ -1065,7 +1073,8 @@ const file_internal_migration_migrate_proto_rawDesc = "" +
 	"volumeSize\x12>\n" +
 	"\rbtrfsFeatures\x18\f \x01(\v2\x18.migration.btrfsFeaturesR\rbtrfsFeatures\x12.\n" +
 	"\x12indexHeaderVersion\x18\r \x01(\rR\x12indexHeaderVersion\x12F\n" +
-	"\x10dependentVolumes\x18\x0e \x03(\v2\x1a.migration.DependentVolumeR\x10dependentVolumes\"F\n" +
+	"\x10dependentVolumes\x18\x0e \x03(\v2\x1a.migration.DependentVolumeR\x10dependentVolumes\x12&\n" +
+	"\x0everifyTransfer\x18\x0f \x01(\bR\x0everifyTransfer\"F\n" +
 	"\x10MigrationControl\x12\x18\n" +
 	"\asuccess\x18\x01 \x02(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
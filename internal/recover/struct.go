@@ -18,13 +18,81 @@ type ValidateVolume struct {
 	Pool          string `json:"pool" yaml:"pool"`                   // Pool the volume belongs to.
 }
 
+// ValidateDependencyError provides info about a missing dependency that is preventing import from proceeding.
+type ValidateDependencyError struct {
+	Kind    string `json:"kind" yaml:"kind"`       // Kind of dependency (project, profile or network).
+	Name    string `json:"name" yaml:"name"`       // Name of the missing dependency.
+	Project string `json:"project" yaml:"project"` // Project the dependency is expected in (empty for projects).
+}
+
+// ValidateUnsupportedPool provides info about a pool whose driver can't discover unknown volumes.
+type ValidateUnsupportedPool struct {
+	Name   string `json:"name" yaml:"name"`     // Name of the pool.
+	Driver string `json:"driver" yaml:"driver"` // Storage driver of the pool.
+}
+
+// ValidateUnsupportedArchitecture provides info about a discovered instance whose architecture the server can't run.
+type ValidateUnsupportedArchitecture struct {
+	Name         string `json:"name" yaml:"name"`                 // Name of the instance.
+	Project      string `json:"project" yaml:"project"`           // Project the instance belongs to.
+	Pool         string `json:"pool" yaml:"pool"`                 // Pool the instance is on.
+	Architecture string `json:"architecture" yaml:"architecture"` // Architecture of the instance.
+}
+
 // ValidateResult returns the result of the validation scan.
 type ValidateResult struct {
-	UnknownVolumes   []ValidateVolume // Volumes that could be imported.
-	DependencyErrors []string         // Errors that are preventing import from proceeding.
+	UnknownVolumes             []ValidateVolume                  // Volumes that could be imported.
+	DependencyErrors           []string                          // Errors that are preventing import from proceeding.
+	StructuredDependencyErrors []ValidateDependencyError         // Same errors as DependencyErrors in structured form.
+	UnsupportedPools           []ValidateUnsupportedPool         // Pools that were skipped as their driver doesn't support volume discovery.
+	UnsupportedArchitectures   []ValidateUnsupportedArchitecture // Instances that can be imported but that this server can't run.
+}
+
+// Recovery import modes.
+const (
+	ImportModeAll       = "all"       // Import instances, custom volumes and buckets.
+	ImportModeBuckets   = "buckets"   // Only import buckets.
+	ImportModeInstances = "instances" // Only import instances.
+	ImportModeVolumes   = "volumes"   // Only import custom volumes.
+)
+
+// DefaultImportWorkers is the default number of instances recovered concurrently.
+const DefaultImportWorkers = 4
+
+// ImportSelector restricts a recovery import to a subset of the discovered volumes.
+type ImportSelector struct {
+	Pool    string   `json:"pool,omitempty" yaml:"pool,omitempty"`       // Only import volumes from this pool.
+	Project string   `json:"project,omitempty" yaml:"project,omitempty"` // Only import volumes from this project.
+	Volumes []string `json:"volumes,omitempty" yaml:"volumes,omitempty"` // Only import instances, volumes or buckets with these names.
 }
 
 // ImportPost is used to initiate a recovert import.
 type ImportPost struct {
-	Pools []api.StoragePoolsPost `json:"pools" yaml:"pools"`
+	Pools    []api.StoragePoolsPost `json:"pools" yaml:"pools"`
+	DryRun   bool                   `json:"dryRun" yaml:"dryRun"`                         // Only report the records that would be created.
+	Selector *ImportSelector        `json:"selector,omitempty" yaml:"selector,omitempty"` // Import only the matching subset.
+	Mode     string                 `json:"mode,omitempty" yaml:"mode,omitempty"`         // What to import (defaults to all).
+	Workers  int                    `json:"workers,omitempty" yaml:"workers,omitempty"`   // Number of instances to recover concurrently.
+}
+
+// ImportRecord provides info about a database record created by the recovery import.
+type ImportRecord struct {
+	Name    string `json:"name" yaml:"name"`       // Name of record.
+	Project string `json:"project" yaml:"project"` // Project the record belongs to (empty for pools).
+	Pool    string `json:"pool" yaml:"pool"`       // Pool the record belongs to.
+}
+
+// ImportUpgrade provides info about a recovered instance whose config predates what the server expects.
+type ImportUpgrade struct {
+	Name    string   `json:"name" yaml:"name"`       // Name of instance.
+	Project string   `json:"project" yaml:"project"` // Project the instance belongs to.
+	Pool    string   `json:"pool" yaml:"pool"`       // Pool the instance is on.
+	Reasons []string `json:"reasons" yaml:"reasons"` // What will be upgraded or needs attention.
+}
+
+// ImportResult returns the records created by the import (or that would be created in dry-run mode).
+type ImportResult struct {
+	DryRun       bool                      `json:"dryRun" yaml:"dryRun"`                                 // Whether the records were only reported.
+	Records      map[string][]ImportRecord `json:"records" yaml:"records"`                               // Records keyed by type (pool, instance, instance-snapshot, volume, volume-snapshot or bucket).
+	NeedsUpgrade []ImportUpgrade           `json:"needsUpgrade,omitempty" yaml:"needsUpgrade,omitempty"` // Recovered instances needing further upgrade steps.
 }
@@ -3,6 +3,7 @@ package backup
 import (
 	"fmt"
 	"io"
+	"path"
 
 	"go.yaml.in/yaml/v4"
 
@@ -158,3 +159,31 @@ func GetInfo(r io.ReadSeeker, sysOS *sys.OS, outputPath string) (*Info, error) {
 
 	return &result, nil
 }
+
+// HasOptimizedData reports whether the backup tarball contains a driver specific binary dump.
+func HasOptimizedData(r io.ReadSeeker, sysOS *sys.OS, outputPath string) (bool, error) {
+	tr, cancelFunc, err := TarReader(r, sysOS, outputPath)
+	if err != nil {
+		return false, err
+	}
+
+	defer cancelFunc()
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break // End of archive.
+		}
+
+		if err != nil {
+			return false, fmt.Errorf("Error reading backup file: %w", err)
+		}
+
+		// Optimized backups store the main volume as a binary file directly under the backup prefix.
+		if path.Dir(hdr.Name) == DefaultBackupPrefix && path.Ext(hdr.Name) == ".bin" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"slices"
+
 	"github.com/lxc/incus/v7/shared/api"
 )
 
@@ -16,3 +18,14 @@ type Config struct {
 	Bucket           *api.StorageBucket           `yaml:"bucket,omitempty"`
 	BucketKeys       []*api.StorageBucketKey      `yaml:"bucket_keys,omitempty"`
 }
+
+// FilterSnapshots restricts the instance and volume snapshots to the given names.
+func (c *Config) FilterSnapshots(names []string) {
+	c.Snapshots = slices.DeleteFunc(c.Snapshots, func(snap *api.InstanceSnapshot) bool {
+		return !slices.Contains(names, snap.Name)
+	})
+
+	c.VolumeSnapshots = slices.DeleteFunc(c.VolumeSnapshots, func(snap *api.StorageVolumeSnapshot) bool {
+		return !slices.Contains(names, snap.Name)
+	})
+}
@@ -125,6 +125,18 @@ func (c *Config) OfflineThreshold() time.Duration {
 	return time.Duration(n) * time.Second
 }
 
+// VolumeSnapshotsInterval returns how often the custom volume snapshot task runs.
+func (c *Config) VolumeSnapshotsInterval() time.Duration {
+	n := c.m.GetInt64("storage.volume_snapshots.interval")
+	return time.Duration(n) * time.Second
+}
+
+// VolumeSnapshotsDelay returns how long to wait between scheduled custom volume snapshots.
+func (c *Config) VolumeSnapshotsDelay() time.Duration {
+	n := c.m.GetInt64("storage.volume_snapshots.delay")
+	return time.Duration(n) * time.Second
+}
+
 // ImagesMinimalReplica returns the numbers of nodes for cluster images replication.
 func (c *Config) ImagesMinimalReplica() int64 {
 	return c.m.GetInt64("cluster.images_minimal_replica")
@@ -1127,6 +1139,24 @@ var ConfigSchema = config.Schema{
 	//  scope: global
 	//  shortdesc: LINSTOR SSL client key
 	"storage.linstor.client_key": {Default: ""},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=storage.volume_snapshots.interval)
+	// Specify the number of seconds (at least 60) between runs of the task that expires and creates scheduled custom volume snapshots. Snapshots scheduled since the previous run are created on the next one.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `60`
+	//  shortdesc: How often to check for custom volume snapshots to expire or create
+	"storage.volume_snapshots.interval": {Type: config.Int64, Default: "60", Validator: volumeSnapshotsIntervalValidator},
+
+	// gendoc:generate(entity=server, group=miscellaneous, key=storage.volume_snapshots.delay)
+	// Specify the number of seconds to wait between scheduled custom volume snapshots, to spread the load when many volumes are due at once. The delay is reduced so that a run never takes longer than `storage.volume_snapshots.interval`.
+	// ---
+	//  type: integer
+	//  scope: global
+	//  defaultdesc: `0`
+	//  shortdesc: Delay between scheduled custom volume snapshots
+	"storage.volume_snapshots.delay": {Type: config.Int64, Default: "0", Validator: validate.Optional(validate.IsInRange(0, 3600))},
 }
 
 func expiryValidator(value string) error {
@@ -1159,6 +1189,22 @@ func offlineThresholdValidator(value string) error {
 	return nil
 }
 
+func volumeSnapshotsIntervalValidator(value string) error {
+	// Snapshot schedules have a one minute granularity.
+	minInterval := 60
+
+	interval, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.New("Volume snapshots interval is not a number")
+	}
+
+	if interval < minInterval {
+		return fmt.Errorf("Value must be at least '%d'", minInterval)
+	}
+
+	return nil
+}
+
 func imageMinimalReplicaValidator(value string) error {
 	count, err := strconv.Atoi(value)
 	if err != nil {
@@ -122,10 +122,21 @@ func ConnectIfInstanceIsRemote(s *state.State, projectName string, instName stri
 // defined. If it's not the local cluster member it will connect to it and return the connected client, otherwise
 // it just returns nil. If there is more than one cluster member with a matching volume name, an error is returned.
 func ConnectIfVolumeIsRemote(s *state.State, poolName string, projectName string, volumeName string, volumeType int, networkCert *localtls.CertInfo, serverCert *localtls.CertInfo, r *http.Request) (incus.InstanceServer, error) {
+	client, _, err := ConnectIfVolumeIsRemoteWithVolume(s, poolName, projectName, volumeName, volumeType, networkCert, serverCert, r)
+
+	return client, err
+}
+
+// ConnectIfVolumeIsRemoteWithVolume is like ConnectIfVolumeIsRemote but, when the volume is served by the local
+// cluster member, it also returns the volume loaded in the same transaction as its location. The returned volume
+// may be nil if it couldn't be loaded alongside the location, in which case callers need to load it themselves.
+func ConnectIfVolumeIsRemoteWithVolume(s *state.State, poolName string, projectName string, volumeName string, volumeType int, networkCert *localtls.CertInfo, serverCert *localtls.CertInfo, r *http.Request) (incus.InstanceServer, *db.StorageVolume, error) {
 	localNodeID := s.DB.Cluster.GetNodeID()
 	var err error
 	var nodes []db.NodeInfo
 	var poolID int64
+	var dbVolume *db.StorageVolume
+	noClusterMember := false
 	err = s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
 		poolID, err = tx.GetStoragePoolID(ctx, poolName)
 		if err != nil {
@@ -133,30 +144,36 @@ func ConnectIfVolumeIsRemote(s *state.State, poolName string, projectName string
 		}
 
 		nodes, err = tx.GetStorageVolumeNodes(ctx, poolID, projectName, volumeName, volumeType)
-		if err != nil {
+		if err != nil && !errors.Is(err, db.ErrNoClusterMember) {
+			return err
+		}
+
+		noClusterMember = errors.Is(err, db.ErrNoClusterMember)
+
+		// GetStoragePoolVolume returns a volume with an empty Location field for remote drivers.
+		if noClusterMember {
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, poolID, projectName, volumeType, volumeName, true)
 			return err
 		}
 
+		// Load a volume on the local member now to spare the caller another lookup.
+		if len(nodes) == 1 && nodes[0].ID == localNodeID {
+			dbVolume, err = tx.GetStoragePoolVolume(ctx, poolID, projectName, volumeType, volumeName, true)
+			if err != nil && !api.StatusErrorCheck(err, http.StatusNotFound) {
+				return err
+			}
+		}
+
 		return nil
 	})
-	if err != nil && !errors.Is(err, db.ErrNoClusterMember) {
-		return nil, err
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// If volume uses a remote storage driver and so has no explicit cluster member, then we need to check
 	// whether it is exclusively attached to remote instance, and if so then we need to forward the request to
 	// the node whereit is currently used. This avoids conflicting with another member when using it locally.
-	if errors.Is(err, db.ErrNoClusterMember) {
-		// GetStoragePoolVolume returns a volume with an empty Location field for remote drivers.
-		var dbVolume *db.StorageVolume
-		err = s.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
-			dbVolume, err = tx.GetStoragePoolVolume(ctx, poolID, projectName, volumeType, volumeName, true)
-			return err
-		})
-		if err != nil {
-			return nil, err
-		}
-
+	if noClusterMember {
 		// Find if volume is attached to a remote instance.
 		var remoteInstance *db.InstanceArgs
 		err = storagePools.VolumeUsedByInstanceDevices(s, poolName, projectName, &dbVolume.StorageVolume, true, func(dbInst db.InstanceArgs, project api.Project, usedByDevices []string) error {
@@ -169,12 +186,12 @@ func ConnectIfVolumeIsRemote(s *state.State, poolName string, projectName string
 			return nil
 		})
 		if err != nil && !errors.Is(err, db.ErrInstanceListStop) {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if remoteInstance == nil {
 			// Volume isn't exclusively attached to an instance. Use local cluster member.
-			return nil, nil
+			return nil, dbVolume, nil
 		}
 
 		var instNode db.NodeInfo
@@ -183,7 +200,7 @@ func ConnectIfVolumeIsRemote(s *state.State, poolName string, projectName string
 			return err
 		})
 		if err != nil {
-			return nil, fmt.Errorf("Failed getting cluster member info for %q: %w", remoteInstance.Node, err)
+			return nil, nil, fmt.Errorf("Failed getting cluster member info for %q: %w", remoteInstance.Node, err)
 		}
 
 		// Replace node list with instance's cluster member node (which might be local member).
@@ -192,27 +209,27 @@ func ConnectIfVolumeIsRemote(s *state.State, poolName string, projectName string
 
 	nodeCount := len(nodes)
 	if nodeCount > 1 {
-		return nil, fmt.Errorf("More than one cluster member has a volume named %q. Please target a specific member", volumeName)
+		return nil, nil, fmt.Errorf("More than one cluster member has a volume named %q. Please target a specific member", volumeName)
 	} else if nodeCount < 1 {
 		// Should never get here.
-		return nil, fmt.Errorf("Volume %q has empty cluster member list", volumeName)
+		return nil, nil, fmt.Errorf("Volume %q has empty cluster member list", volumeName)
 	}
 
 	node := nodes[0]
 	if node.ID == localNodeID {
 		// Use local cluster member if volume belongs to this local member.
-		return nil, nil
+		return nil, dbVolume, nil
 	}
 
 	// Connect to remote cluster member.
 	client, err := Connect(node.Address, networkCert, serverCert, r, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	client = client.UseProject(projectName)
 
-	return client, nil
+	return client, nil, nil
 }
 
 // SetupTrust is a convenience around InstanceServer.CreateCertificate that adds the given server certificate to
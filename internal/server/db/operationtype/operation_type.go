@@ -80,6 +80,7 @@ const (
 	BucketBackupRename
 	BucketBackupRestore
 	VolumeRebuild
+	VolumeBulkDelete
 )
 
 // Description return a human-readable description of the operation type.
@@ -213,6 +214,8 @@ func (t Type) Description() string {
 		return "Renaming bucket backup"
 	case BucketBackupRestore:
 		return "Restoring bucket backup"
+	case VolumeBulkDelete:
+		return "Deleting storage volumes"
 	default:
 		return "Executing operation"
 	}
@@ -296,6 +299,8 @@ func (t Type) Permission() (auth.ObjectType, auth.Entitlement) {
 		return auth.ObjectTypeStorageVolume, auth.EntitlementCanEdit
 	case VolumeRebuild:
 		return auth.ObjectTypeStorageVolume, auth.EntitlementCanEdit
+	case VolumeBulkDelete:
+		return auth.ObjectTypeStorageVolume, auth.EntitlementCanEdit
 
 	case BucketBackupCreate:
 		return auth.ObjectTypeStorageVolume, auth.EntitlementCanManageBackups
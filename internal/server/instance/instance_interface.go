@@ -266,6 +266,7 @@ type MigrateSendArgs struct {
 
 	AllowInconsistent bool
 	Devices           api.DevicesMap
+	SnapshotNames     []string // Only send the listed snapshots (all if empty).
 }
 
 // MigrateReceiveArgs represent arguments for instance migration receive.
@@ -1,29 +1,57 @@
 package ip
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"strings"
+	"unicode"
 
 	"github.com/vishvananda/netlink"
-
 	"golang.org/x/sys/unix"
+
+	"github.com/lxc/incus/v7/shared/logger"
+	"github.com/lxc/incus/v7/shared/revert"
 )
 
 // Addr represents arguments for address protocol manipulation.
 type Addr struct {
-	DevName string
-	Address *net.IPNet
-	Scope   string
-	Family  Family
+	DevName  string
+	Address  *net.IPNet
+	Scope    string
+	Family   Family // FamilyAll matches both IPv4 and IPv6, or the family of Address where one is needed.
+	Congctl  string
+	Priority int // Route metric applied by SetRouteCC, left to the default when zero.
+}
+
+// family returns the address family, inferring it from the address when unset.
+func (a *Addr) family() Family {
+	if a.Family != FamilyAll || a.Address == nil {
+		return a.Family
+	}
+
+	return ipFamily(a.Address.IP)
+}
+
+// ipFamily returns the family of the given IP address.
+func ipFamily(ip net.IP) Family {
+	if ip.To4() != nil {
+		return FamilyV4
+	}
+
+	return FamilyV6
 }
 
 // Add adds new protocol address.
+// If Family is unset, it is set from the address so later calls on the same Addr use it.
 func (a *Addr) Add() error {
 	scope, err := a.scopeNum()
 	if err != nil {
 		return err
 	}
 
+	a.Family = a.family()
+
 	err = netlink.AddrAdd(&netlink.GenericLink{
 		LinkAttrs: netlink.LinkAttrs{
 			Name: a.DevName,
@@ -33,7 +61,29 @@ func (a *Addr) Add() error {
 		Scope: scope,
 	})
 	if err != nil {
-		return fmt.Errorf("Failed to add address %q: %w", a.Address.String(), err)
+		return fmt.Errorf("Failed to add %s address %q to device %s (AddrAdd): %w", a.Family, a.Address.String(), a.DevName, err)
+	}
+
+	return nil
+}
+
+// Replace adds the protocol address or updates it if already present, without removing it first.
+func (a *Addr) Replace() error {
+	scope, err := a.scopeNum()
+	if err != nil {
+		return err
+	}
+
+	err = netlink.AddrReplace(&netlink.GenericLink{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: a.DevName,
+		},
+	}, &netlink.Addr{
+		IPNet: a.Address,
+		Scope: scope,
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to replace %s address %q on device %s (AddrReplace): %w", a.family(), a.Address.String(), a.DevName, err)
 	}
 
 	return nil
@@ -59,7 +109,38 @@ func (a *Addr) scopeNum() (int, error) {
 	return int(scope), nil
 }
 
+// List returns the protocol addresses currently assigned to the device.
+// If Family is unset, addresses of both families are returned.
+func (a *Addr) List() ([]*net.IPNet, error) {
+	link, err := linkByName(a.DevName)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := netlink.AddrList(link, int(a.Family))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get %s addresses for device %s (AddrList): %w", a.Family, a.DevName, err)
+	}
+
+	scope, err := a.scopeNum()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*net.IPNet, 0, len(addrs))
+	for _, addr := range addrs {
+		if a.Scope != "" && scope != addr.Scope {
+			continue
+		}
+
+		result = append(result, addr.IPNet)
+	}
+
+	return result, nil
+}
+
 // Flush flushes protocol addresses.
+// If Family is unset, addresses of both families are removed.
 func (a *Addr) Flush() error {
 	link, err := linkByName(a.DevName)
 	if err != nil {
@@ -68,7 +149,7 @@ func (a *Addr) Flush() error {
 
 	addrs, err := netlink.AddrList(link, int(a.Family))
 	if err != nil {
-		return fmt.Errorf("Failed to get addresses for device %s: %w", a.DevName, err)
+		return fmt.Errorf("Failed to get %s addresses for device %s (AddrList): %w", a.Family, a.DevName, err)
 	}
 
 	scope, err := a.scopeNum()
@@ -85,23 +166,49 @@ func (a *Addr) Flush() error {
 
 		err := netlink.AddrDel(link, &addr)
 		if err != nil {
-			return fmt.Errorf("Failed to delete address %v: %w", addr, err)
+			return fmt.Errorf("Failed to delete %s address %q from device %s (AddrDel): %w", ipFamily(addr.IP), addr.IPNet.String(), a.DevName, err)
 		}
 	}
 
 	return nil
 }
 
-// Find and replace the default local route if CC need reset
-func (a *Addr) SetRouteCC() error {
+// congctlName returns the congestion control algorithm to apply, defaulting to highspeed.
+func (a *Addr) congctlName() (string, error) {
+	if a.Congctl == "" {
+		return "highspeed", nil
+	}
+
+	name := strings.TrimSpace(a.Congctl)
+	if name == "" {
+		return "", errors.New("Congestion control algorithm name cannot be empty")
+	}
+
+	for _, r := range name {
+		if !unicode.IsLower(r) && !unicode.IsDigit(r) && r != '_' {
+			return "", fmt.Errorf("Invalid congestion control algorithm name %q", name)
+		}
+	}
+
+	return name, nil
+}
+
+// SetRouteCC finds and replaces the default local route if its congestion control needs a reset.
+// It returns whether a route was modified.
+func (a *Addr) SetRouteCC() (bool, error) {
+	congctl, err := a.congctlName()
+	if err != nil {
+		return false, err
+	}
+
 	link, err := netlink.LinkByName(a.DevName)
 	if err != nil {
-		return fmt.Errorf("Failed to change CC (Device): %w", err)
+		return false, fmt.Errorf("Failed to change CC on device %s (LinkByName): %w", a.DevName, err)
 	}
 
 	_, dstNet, err := net.ParseCIDR(a.Address.String())
 	if err != nil {
-		return fmt.Errorf("Failed to change CC (ParseCIDR): %w", err)
+		return false, fmt.Errorf("Failed to change CC on device %s (ParseCIDR): %w", a.DevName, err)
 	}
 
 	filter := &netlink.Route{
@@ -111,33 +218,75 @@ func (a *Addr) SetRouteCC() error {
 		Protocol: unix.RTPROT_KERNEL,
 	}
 
-	routes, err := netlink.RouteListFiltered(int(a.Family), filter, netlink.RT_FILTER_OIF|netlink.RT_FILTER_DST|netlink.RT_FILTER_PROTOCOL)
+	family := a.family()
+	routes, err := netlink.RouteListFiltered(int(family), filter, netlink.RT_FILTER_OIF|netlink.RT_FILTER_DST|netlink.RT_FILTER_PROTOCOL)
 	if err != nil {
-		return fmt.Errorf("Failed to change CC (FilterRouteList): %w", err)
+		return false, fmt.Errorf("Failed to change %s CC on device %s (RouteListFiltered): %w", family, a.DevName, err)
 	}
 
 	// This is normal if the change called multiple times without reset.
 	if len(routes) == 0 {
-		return nil
+		logger.Debug("No kernel route to change CC on", logger.Ctx{"device": a.DevName, "dst": dstNet.String()})
+		return false, nil
 	}
 
 	route := routes[0]
-	if int(a.Family) == unix.AF_INET6 {
-		_ = netlink.RouteDel(&route)
-		route.Priority = 1
+	if route.Congctl == congctl && (a.Priority == 0 || route.Priority == a.Priority) {
+		logger.Debug("Route CC already set", logger.Ctx{"device": a.DevName, "dst": dstNet.String(), "congctl": congctl})
+		return false, nil
+	}
+
+	priority := a.Priority
+	if priority == 0 && family == FamilyV6 {
+		priority = 1
 	}
-	route.Congctl = "highspeed"
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// The metric is part of the route key, so changing it requires re-adding the route.
+	readd := family == FamilyV6 || (priority != 0 && priority != route.Priority)
+	if readd {
+		err = netlink.RouteDel(&route)
+		if err != nil {
+			return false, fmt.Errorf("Failed to change %s CC for route %s on device %s (RouteDel): %w", family, dstNet.String(), a.DevName, err)
+		}
+
+		// Put the original route back if the new one can't be added.
+		origRoute := route
+		reverter.Add(func() {
+			err := netlink.RouteAdd(&origRoute)
+			if err != nil {
+				logger.Warn("Failed restoring route after CC change failure", logger.Ctx{"device": a.DevName, "dst": dstNet.String(), "err": err})
+			}
+		})
+
+		route.Priority = priority
+	}
+
+	route.Congctl = congctl
 	// Mark this is a modified one ?
 	route.Protocol = unix.RTPROT_BOOT
 
-	if int(a.Family) == unix.AF_INET6 {
+	op := "RouteChange"
+	if readd {
+		op = "RouteAdd"
 		err = netlink.RouteAdd(&route)
 	} else {
 		err = netlink.RouteChange(&route)
 	}
+
 	if err != nil {
-		return fmt.Errorf("Failed to change CC (Change): %w", err)
+		if errors.Is(err, unix.ENOENT) || errors.Is(err, unix.EINVAL) {
+			return false, fmt.Errorf("Failed to change %s CC for route %s on device %s (%s): Congestion control algorithm %q may not be available in the kernel: %w", family, dstNet.String(), a.DevName, op, congctl, err)
+		}
+
+		return false, fmt.Errorf("Failed to change %s CC for route %s on device %s (%s): %w", family, dstNet.String(), a.DevName, op, err)
 	}
 
-	return nil
+	reverter.Success()
+
+	logger.Debug("Changed route CC", logger.Ctx{"device": a.DevName, "dst": dstNet.String(), "congctl": congctl})
+
+	return true, nil
 }
@@ -0,0 +1,177 @@
+package ip
+
+import (
+	"net"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+)
+
+// setupTestNetns moves the calling thread into a new network namespace for the duration of the test.
+func setupTestNetns(t *testing.T) {
+	t.Helper()
+
+	if os.Geteuid() != 0 {
+		t.Skip("Network namespace tests require root")
+	}
+
+	runtime.LockOSThread()
+
+	origin, err := netns.Get()
+	if err != nil {
+		runtime.UnlockOSThread()
+		t.Fatalf("Failed getting current network namespace: %v", err)
+	}
+
+	ns, err := netns.New()
+	if err != nil {
+		_ = origin.Close()
+		runtime.UnlockOSThread()
+		t.Skipf("Failed creating network namespace: %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = netns.Set(origin)
+		_ = ns.Close()
+		_ = origin.Close()
+		runtime.UnlockOSThread()
+	})
+}
+
+func TestAddrReplace(t *testing.T) {
+	setupTestNetns(t)
+
+	dummy := &Dummy{Link: Link{Name: "test0"}}
+	err := dummy.Add()
+	if err != nil {
+		t.Skipf("Failed creating dummy link: %v", err)
+	}
+
+	_, address, _ := net.ParseCIDR("192.0.2.1/24")
+	address.IP = net.ParseIP("192.0.2.1")
+
+	addr := &Addr{
+		DevName: "test0",
+		Address: address,
+		Family:  FamilyV4,
+	}
+
+	err = addr.Add()
+	if err != nil {
+		t.Fatalf("Failed adding address: %v", err)
+	}
+
+	// Replacing an existing address must succeed where Add would fail.
+	err = addr.Add()
+	if err == nil {
+		t.Fatal("Expected adding a duplicate address to fail")
+	}
+
+	err = addr.Replace()
+	if err != nil {
+		t.Fatalf("Failed replacing address: %v", err)
+	}
+
+	link, err := netlink.LinkByName("test0")
+	if err != nil {
+		t.Fatalf("Failed getting link: %v", err)
+	}
+
+	addrs, err := netlink.AddrList(link, netlink.FAMILY_V4)
+	if err != nil {
+		t.Fatalf("Failed listing addresses: %v", err)
+	}
+
+	if len(addrs) != 1 || addrs[0].IPNet.String() != address.String() {
+		t.Fatalf("Unexpected addresses after replace: %v", addrs)
+	}
+
+	// Replacing on a missing device must fail.
+	addr.DevName = "missing0"
+	err = addr.Replace()
+	if err == nil {
+		t.Fatal("Expected replacing an address on a missing device to fail")
+	}
+}
+
+func TestAddrFamily(t *testing.T) {
+	tests := []struct {
+		address string
+		family  Family
+		want    Family
+	}{
+		{address: "192.0.2.1/24", want: FamilyV4},
+		{address: "2001:db8::1/64", want: FamilyV6},
+		{address: "192.0.2.1/24", family: FamilyV6, want: FamilyV6},
+	}
+
+	for _, tt := range tests {
+		_, address, err := net.ParseCIDR(tt.address)
+		if err != nil {
+			t.Fatalf("Failed parsing %q: %v", tt.address, err)
+		}
+
+		addr := &Addr{Address: address, Family: tt.family}
+		got := addr.family()
+		if got != tt.want {
+			t.Errorf("Unexpected family for %q (set to %d): got %d, want %d", tt.address, tt.family, got, tt.want)
+		}
+	}
+}
+
+func TestAddrSetRouteCCPriority(t *testing.T) {
+	setupTestNetns(t)
+
+	dummy := &Dummy{Link: Link{Name: "test0"}}
+	err := dummy.Add()
+	if err != nil {
+		t.Skipf("Failed creating dummy link: %v", err)
+	}
+
+	err = dummy.SetUp()
+	if err != nil {
+		t.Fatalf("Failed bringing up dummy link: %v", err)
+	}
+
+	_, address, _ := net.ParseCIDR("192.0.2.1/24")
+	address.IP = net.ParseIP("192.0.2.1")
+
+	addr := &Addr{
+		DevName:  "test0",
+		Address:  address,
+		Congctl:  "reno",
+		Priority: 100,
+	}
+
+	err = addr.Add()
+	if err != nil {
+		t.Fatalf("Failed adding address: %v", err)
+	}
+
+	changed, err := addr.SetRouteCC()
+	if err != nil {
+		t.Fatalf("Failed setting route CC: %v", err)
+	}
+
+	if !changed {
+		t.Fatal("Expected the kernel route to be changed")
+	}
+
+	link, err := netlink.LinkByName("test0")
+	if err != nil {
+		t.Fatalf("Failed getting link: %v", err)
+	}
+
+	_, dst, _ := net.ParseCIDR("192.0.2.0/24")
+	routes, err := netlink.RouteListFiltered(netlink.FAMILY_V4, &netlink.Route{LinkIndex: link.Attrs().Index, Dst: dst}, netlink.RT_FILTER_OIF|netlink.RT_FILTER_DST)
+	if err != nil {
+		t.Fatalf("Failed listing routes: %v", err)
+	}
+
+	if len(routes) != 1 || routes[0].Priority != addr.Priority || routes[0].Congctl != "reno" {
+		t.Fatalf("Unexpected routes after changing CC: %v", routes)
+	}
+}
@@ -24,6 +24,20 @@ const (
 	FamilyV6 Family = unix.AF_INET6
 )
 
+// String returns the human readable name of the family.
+func (f Family) String() string {
+	switch f {
+	case FamilyV4:
+		return "IPv4"
+	case FamilyV6:
+		return "IPv6"
+	case FamilyAll:
+		return "IPv4/IPv6"
+	default:
+		return fmt.Sprintf("family %d", int(f))
+	}
+}
+
 func linkByName(name string) (netlink.Link, error) {
 	link, err := netlink.LinkByName(name)
 	if err != nil {
@@ -96,6 +96,7 @@ type VolumeTargetArgs struct {
 	ClusterMoveSourceName string
 	StoragePool           string
 	DependentVolumes      []DependentVolumeArgs
+	Resume                bool // Keep the received snapshots if the transfer fails.
 }
 
 // TypesToHeader converts one or more Types to a MigrationHeader. It uses the first type argument
@@ -348,20 +349,42 @@ func VolumeSnapshotToProtobuf(vol *api.StorageVolumeSnapshot) *migration.Snapsho
 	}
 }
 
+// ProgressTransferredKey is the operation metadata key holding the number of bytes transferred so far.
+const ProgressTransferredKey = "transferred_bytes"
+
+// ProgressTotalKey is the operation metadata key holding the expected number of bytes to transfer.
+const ProgressTotalKey = "total_bytes"
+
 func progressWrapperRender(op *operations.Operation, key string, description string, progressInt int64, speedInt int64) {
 	meta := map[string]any{}
 
 	progress := fmt.Sprintf("%s (%s/s)", units.GetByteSizeString(progressInt, 2), units.GetByteSizeString(speedInt, 2))
+	total, _ := op.Metadata()[ProgressTotalKey].(int64)
+	if total > 0 && progressInt <= total {
+		progress = fmt.Sprintf("%d%% (%s/s)", progressInt*100/total, units.GetByteSizeString(speedInt, 2))
+	}
+
 	if description != "" {
-		progress = fmt.Sprintf("%s: %s (%s/s)", description, units.GetByteSizeString(progressInt, 2), units.GetByteSizeString(speedInt, 2))
+		progress = fmt.Sprintf("%s: %s", description, progress)
 	}
 
 	if meta[key] != progress {
 		meta[key] = progress
+		meta[ProgressTransferredKey] = progressInt
 		_ = op.ExtendMetadata(meta)
 	}
 }
 
+// SetProgressTotal records the expected number of bytes for a transfer in the operation metadata.
+// A non-positive total leaves the progress indeterminate.
+func SetProgressTotal(op *operations.Operation, total int64) {
+	if op == nil || total <= 0 {
+		return
+	}
+
+	_ = op.ExtendMetadata(map[string]any{ProgressTotalKey: total})
+}
+
 // ProgressReader reports the read progress.
 func ProgressReader(op *operations.Operation, key string, description string) func(io.ReadCloser) io.ReadCloser {
 	return func(reader io.ReadCloser) io.ReadCloser {
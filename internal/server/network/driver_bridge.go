@@ -1927,12 +1927,16 @@ func (n *bridge) setup(oldConfig map[string]string) error {
 				_ = br.SetUp()
 			}
 			time.Sleep(120 * time.Millisecond)
-			err = addr.SetRouteCC()
+			changed, err := addr.SetRouteCC()
 			_ = DetachInterface(n.state, n.name, fmt.Sprintf("%s_keepup", n.name))
 			_ = keepup.Delete()
 			if err != nil {
 				return err
 			}
+
+			if changed {
+				n.logger.Debug("Reset congestion control of local route", logger.Ctx{"address": addr.Address.String()})
+			}
 		}
 		return nil
 	}
@@ -321,6 +321,20 @@ func NotImplemented(err error) Response {
 	return &errorResponse{http.StatusNotImplemented, message}
 }
 
+// NotModified returns a not modified response (304) carrying the ETag of the given data.
+func NotModified(etag any) Response {
+	return ManualResponse(func(w http.ResponseWriter) error {
+		hash, err := localUtil.EtagHash(etag)
+		if err == nil {
+			w.Header().Set("ETag", fmt.Sprintf("\"%s\"", hash))
+		}
+
+		w.WriteHeader(http.StatusNotModified)
+
+		return nil
+	})
+}
+
 // PreconditionFailed returns a precondition failed response (412) with the
 // given error.
 func PreconditionFailed(err error) Response {
@@ -1032,7 +1032,7 @@ func (b *backend) CreateInstanceFromBackup(srcBackup backup.Info, srcData io.Rea
 }
 
 // CreateInstanceFromCopy copies an instance volume and optionally its snapshots to new volume(s).
-func (b *backend) CreateInstanceFromCopy(inst instance.Instance, src instance.Instance, snapshots bool, allowInconsistent bool, op *operations.Operation) error {
+func (b *backend) CreateInstanceFromCopy(inst instance.Instance, src instance.Instance, snapshots bool, onlySnapshots []string, allowInconsistent bool, op *operations.Operation) error {
 	l := b.logger.AddContext(logger.Ctx{"project": inst.Project().Name, "instance": inst.Name(), "src": src.Name(), "snapshots": snapshots})
 	l.Debug("CreateInstanceFromCopy started")
 	defer l.Debug("CreateInstanceFromCopy finished")
@@ -1070,6 +1070,12 @@ func (b *backend) CreateInstanceFromCopy(inst instance.Instance, src instance.In
 		return fmt.Errorf("Failed generating instance copy config: %w", err)
 	}
 
+	// Only keep the selected snapshots.
+	filterSnapshots := snapshots && len(onlySnapshots) > 0
+	if filterSnapshots {
+		srcConfig.FilterSnapshots(onlySnapshots)
+	}
+
 	// If we are copying snapshots, retrieve a list of snapshots from source volume.
 	var snapshotNames []string
 	if snapshots {
@@ -1111,7 +1117,8 @@ func (b *backend) CreateInstanceFromCopy(inst instance.Instance, src instance.In
 
 	reverter.Add(func() { _ = b.DeleteInstance(inst, op) })
 
-	if b.Name() == srcPool.Name() {
+	// Drivers always copy all snapshots, so a snapshot selection goes through the migration system.
+	if b.Name() == srcPool.Name() && !filterSnapshots {
 		l.Debug("CreateInstanceFromCopy same-pool mode detected")
 
 		// Get the src volume name on storage.
@@ -1183,9 +1190,9 @@ func (b *backend) CreateInstanceFromCopy(inst instance.Instance, src instance.In
 			return err
 		}
 	} else {
-		// We are copying volumes between storage pools so use migration system as it will
-		// be able to negotiate a common transfer method between pool types.
-		l.Debug("CreateInstanceFromCopy cross-pool mode detected")
+		// We are copying volumes between storage pools (or only some snapshots) so use migration system
+		// as it will be able to negotiate a common transfer method between pool types.
+		l.Debug("CreateInstanceFromCopy migration mode detected")
 
 		// Negotiate the migration type to use.
 		offeredTypes := srcPool.MigrationTypes(contentType, false, snapshots, false, true)
@@ -1496,6 +1503,9 @@ func (b *backend) RefreshCustomVolume(projectName string, srcProjectName string,
 			}
 		}
 
+		// Record the expected transfer size so progress can be reported as a percentage.
+		localMigration.SetProgressTotal(op, customVolumeCopySize(srcPool, srcProjectName, srcVolName, contentType, volSize))
+
 		ctx, cancel := context.WithCancel(context.Background())
 
 		// Use in-memory pipe pair to simulate a connection between the sender and receiver.
@@ -1804,6 +1814,72 @@ func (b *backend) imageFiller(fingerprint string, op *operations.Operation) func
 	}
 }
 
+// imageRootfsFiller returns a function that can be used as a filler function with CreateVolume().
+// The function returned will unpack the specified container image into the volume and then move
+// the content of its rootfs to the top of the volume, discarding the image metadata.
+func (b *backend) imageRootfsFiller(fingerprint string, op *operations.Operation) func(vol drivers.Volume, rootBlockPath string, allowUnsafeResize bool, targetIsZero bool, targetFormat string) (int64, error) {
+	fill := b.imageFiller(fingerprint, op)
+
+	return func(vol drivers.Volume, rootBlockPath string, allowUnsafeResize bool, targetIsZero bool, targetFormat string) (int64, error) {
+		_, err := fill(vol, "", allowUnsafeResize, targetIsZero, targetFormat)
+		if err != nil {
+			return -1, err
+		}
+
+		mountPath := vol.MountPath()
+
+		// Move the rootfs aside first so its content can't clash with the image metadata.
+		tmpPath, err := os.MkdirTemp(mountPath, ".rootfs-")
+		if err != nil {
+			return -1, err
+		}
+
+		tmpRootfsPath := filepath.Join(tmpPath, "rootfs")
+
+		err = os.Rename(filepath.Join(mountPath, "rootfs"), tmpRootfsPath)
+		if err != nil {
+			return -1, err
+		}
+
+		entries, err := os.ReadDir(mountPath)
+		if err != nil {
+			return -1, err
+		}
+
+		for _, entry := range entries {
+			if entry.Name() == filepath.Base(tmpPath) || entry.Name() == "lost+found" {
+				continue
+			}
+
+			err = os.RemoveAll(filepath.Join(mountPath, entry.Name()))
+			if err != nil {
+				return -1, err
+			}
+		}
+
+		entries, err = os.ReadDir(tmpRootfsPath)
+		if err != nil {
+			return -1, err
+		}
+
+		for _, entry := range entries {
+			target := filepath.Join(mountPath, entry.Name())
+
+			err = os.RemoveAll(target)
+			if err != nil {
+				return -1, err
+			}
+
+			err = os.Rename(filepath.Join(tmpRootfsPath, entry.Name()), target)
+			if err != nil {
+				return -1, err
+			}
+		}
+
+		return 0, os.RemoveAll(tmpPath)
+	}
+}
+
 // isoFiller returns a function that can be used as a filler function with CreateVolume().
 // The function returned will copy the ISO content into the specified mount path
 // provided.
@@ -5028,10 +5104,105 @@ func (b *backend) CreateCustomVolume(projectName string, volName string, desc st
 	return nil
 }
 
+// CreateCustomVolumeFromImage creates a filesystem custom volume populated with the rootfs of a container image.
+// The image must already be available locally.
+func (b *backend) CreateCustomVolumeFromImage(projectName string, volName string, desc string, config map[string]string, fingerprint string, op *operations.Operation) error {
+	l := b.logger.AddContext(logger.Ctx{"project": projectName, "volName": volName, "desc": desc, "config": config, "fingerprint": fingerprint})
+	l.Debug("CreateCustomVolumeFromImage started")
+	defer l.Debug("CreateCustomVolumeFromImage finished")
+
+	err := b.isStatusReady()
+	if err != nil {
+		return err
+	}
+
+	storagePoolSupported := slices.Contains(b.Driver().Info().VolumeTypes, drivers.VolumeTypeCustom)
+	if !storagePoolSupported {
+		return errors.New("Storage pool does not support custom volume type")
+	}
+
+	// Get the volume name on storage.
+	volStorageName := project.StorageVolume(projectName, volName)
+	vol := b.GetVolume(drivers.VolumeTypeCustom, drivers.ContentTypeFS, volStorageName, config)
+
+	volExists, err := b.driver.HasVolume(vol)
+	if err != nil {
+		return err
+	}
+
+	if volExists {
+		return errors.New("Cannot create volume, already exists on target storage")
+	}
+
+	reverter := revert.New()
+	defer reverter.Fail()
+
+	// Validate config and create database entry for new storage volume.
+	err = VolumeDBCreate(b, projectName, volName, desc, vol.Type(), false, vol.Config(), time.Now().UTC(), time.Time{}, vol.ContentType(), false, false)
+	if err != nil {
+		return err
+	}
+
+	reverter.Add(func() { _ = VolumeDBDelete(b, projectName, volName, vol.Type()) })
+
+	volFiller := drivers.VolumeFiller{
+		Fill: b.imageRootfsFiller(fingerprint, op),
+	}
+
+	// Unpack the image rootfs into the new storage volume.
+	err = b.driver.CreateVolume(vol, &volFiller, op)
+	if err != nil {
+		return fmt.Errorf("Failed creating volume: %w", err)
+	}
+
+	eventCtx := logger.Ctx{"type": vol.Type()}
+
+	var location string
+	if b.state.ServerClustered && !b.Driver().Info().Remote {
+		eventCtx["location"] = b.state.ServerName
+		location = b.state.ServerName
+	}
+
+	// Record new volume with authorizer.
+	err = b.state.Authorizer.AddStoragePoolVolume(b.state.ShutdownCtx, projectName, b.Name(), vol.Type().Singular(), volName, location)
+	if err != nil {
+		logger.Error("Failed to add storage volume to authorizer", logger.Ctx{"name": volName, "type": vol.Type(), "pool": b.Name(), "project": projectName, "error": err})
+	}
+
+	b.state.Events.SendLifecycle(projectName, lifecycle.StorageVolumeCreated.Event(vol, string(vol.Type()), projectName, op, eventCtx))
+
+	reverter.Success()
+	return nil
+}
+
 // CreateCustomVolumeFromCopy creates a custom volume from an existing custom volume.
 // It copies the snapshots from the source volume by default, but can be disabled if requested.
 func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName string, volName string, desc string, config map[string]string, srcPoolName, srcVolName string, snapshots bool, op *operations.Operation) error {
-	l := b.logger.AddContext(logger.Ctx{"project": projectName, "srcProjectName": srcProjectName, "volName": volName, "desc": desc, "config": config, "srcPoolName": srcPoolName, "srcVolName": srcVolName, "snapshots": snapshots})
+	return b.createCustomVolumeFromCopy(projectName, srcProjectName, volName, desc, config, srcPoolName, srcVolName, snapshots, "", nil, op)
+}
+
+// CloneCustomVolume is like CreateCustomVolumeFromCopy but also returns whether a copy-on-write clone was used.
+func (b *backend) CloneCustomVolume(projectName string, srcProjectName string, volName string, desc string, config map[string]string, srcPoolName, srcVolName string, snapshots bool, op *operations.Operation) (bool, error) {
+	cloned := false
+	err := b.createCustomVolumeFromCopy(projectName, srcProjectName, volName, desc, config, srcPoolName, srcVolName, snapshots, "", &cloned, op)
+	if err != nil {
+		return false, err
+	}
+
+	return cloned, nil
+}
+
+// ConvertCustomVolumeFromCopy creates a custom volume of the given content type from an existing custom volume.
+// Snapshots aren't copied as they keep the source content type.
+func (b *backend) ConvertCustomVolumeFromCopy(projectName string, srcProjectName string, volName string, desc string, config map[string]string, srcPoolName, srcVolName string, contentType drivers.ContentType, op *operations.Operation) error {
+	return b.createCustomVolumeFromCopy(projectName, srcProjectName, volName, desc, config, srcPoolName, srcVolName, false, contentType, nil, op)
+}
+
+// createCustomVolumeFromCopy copies a custom volume, optionally converting it to targetContentType.
+// An empty targetContentType keeps the content type of the source volume.
+// If cloned isn't nil, it is set to whether the driver's copy-on-write clone was used.
+func (b *backend) createCustomVolumeFromCopy(projectName string, srcProjectName string, volName string, desc string, config map[string]string, srcPoolName, srcVolName string, snapshots bool, targetContentType drivers.ContentType, cloned *bool, op *operations.Operation) error {
+	l := b.logger.AddContext(logger.Ctx{"project": projectName, "srcProjectName": srcProjectName, "volName": volName, "desc": desc, "config": config, "srcPoolName": srcPoolName, "srcVolName": srcVolName, "snapshots": snapshots, "targetContentType": targetContentType})
 	l.Debug("CreateCustomVolumeFromCopy started")
 	defer l.Debug("CreateCustomVolumeFromCopy finished")
 
@@ -5083,6 +5254,20 @@ func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName
 		return err
 	}
 
+	// Get the content type of the new volume.
+	volContentType := contentType
+	if targetContentType != "" {
+		volContentType = targetContentType
+	}
+
+	converting := volContentType != contentType
+	if converting {
+		err = ValidateCustomVolumeConversion(contentType, volContentType)
+		if err != nil {
+			return err
+		}
+	}
+
 	storagePoolSupported := slices.Contains(b.Driver().Info().VolumeTypes, drivers.VolumeTypeCustom)
 
 	if !storagePoolSupported {
@@ -5106,8 +5291,8 @@ func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName
 	srcVol := srcPool.GetVolume(drivers.VolumeTypeCustom, contentType, srcVolStorageName, srcConfig.Volume.Config)
 
 	// If the source and target are in the same pool then use CreateVolumeFromCopy rather than
-	// migration system as it will be quicker.
-	if srcPool == b {
+	// migration system as it will be quicker. Conversions always go through the migration system.
+	if srcPool == b && !converting {
 		l.Debug("CreateCustomVolumeFromCopy same-pool mode detected")
 
 		// Get the volume name on storage.
@@ -5144,6 +5329,10 @@ func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName
 			return err
 		}
 
+		if cloned != nil {
+			*cloned = drivers.IsCloneCopy(b.driver, srcVol, snapshots)
+		}
+
 		eventCtx := logger.Ctx{"type": vol.Type()}
 
 		var location string
@@ -5171,7 +5360,7 @@ func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName
 	// Negotiate the migration type to use.
 	offeredTypes := srcPool.MigrationTypes(contentType, false, snapshots, false, true)
 	offerHeader := localMigration.TypesToHeader(offeredTypes...)
-	migrationTypes, err := localMigration.MatchTypes(offerHeader, FallbackMigrationType(contentType), b.MigrationTypes(contentType, false, snapshots, false, true))
+	migrationTypes, err := localMigration.MatchTypes(offerHeader, FallbackMigrationType(contentType), b.MigrationTypes(volContentType, false, snapshots, false, true))
 	if err != nil {
 		return fmt.Errorf("Failed to negotiate copy migration type: %w", err)
 	}
@@ -5213,6 +5402,9 @@ func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName
 		}
 	}
 
+	// Record the expected transfer size so progress can be reported as a percentage.
+	localMigration.SetProgressTotal(op, customVolumeCopySize(srcPool, srcProjectName, srcVolName, contentType, volSize))
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// Use in-memory pipe pair to simulate a connection between the sender and receiver.
@@ -5249,7 +5441,7 @@ func (b *backend) CreateCustomVolumeFromCopy(projectName string, srcProjectName
 			Snapshots:          migrationSnapshots,
 			MigrationType:      migrationTypes[0],
 			TrackProgress:      false, // Do not use a progress tracker on receiver.
-			ContentType:        string(contentType),
+			ContentType:        string(volContentType),
 			VolumeSize:         volSize, // Block size setting override.
 			VolumeOnly:         !snapshots,
 			StoragePool:        srcPool.Name(),
@@ -5501,18 +5693,31 @@ func (b *backend) CreateCustomVolumeFromMigration(projectName string, conn io.Re
 		return err
 	}
 
+	qcow2Target := b.driver.Info().TargetFormat == drivers.BlockVolumeTypeQcow2 && (!b.driver.Info().Remote || args.ClusterMoveSourceName == "" || args.StoragePool != "")
+	if args.Resume && qcow2Target {
+		return errors.New("Resumable migrations aren't supported on this storage pool")
+	}
+
 	reverter := revert.New()
 	defer reverter.Fail()
 
 	if !args.Refresh {
+		dbConfig := vol.Config()
+		if args.Resume {
+			dbConfig = maps.Clone(dbConfig)
+			dbConfig[VolumeMigrationPartialKey] = "true"
+		}
+
 		// Validate config and create database entry for new storage volume.
 		// Strip unsupported config keys (in case the export was made from a different type of storage pool).
-		err = VolumeDBCreate(b, projectName, args.Name, args.Description, vol.Type(), false, vol.Config(), time.Now().UTC(), time.Time{}, vol.ContentType(), true, true)
+		err = VolumeDBCreate(b, projectName, args.Name, args.Description, vol.Type(), false, dbConfig, time.Now().UTC(), time.Time{}, vol.ContentType(), true, true)
 		if err != nil {
 			return err
 		}
 
-		reverter.Add(func() { _ = VolumeDBDelete(b, projectName, args.Name, vol.Type()) })
+		if !args.Resume {
+			reverter.Add(func() { _ = VolumeDBDelete(b, projectName, args.Name, vol.Type()) })
+		}
 	}
 
 	if len(args.Snapshots) > 0 {
@@ -5553,11 +5758,18 @@ func (b *backend) CreateCustomVolumeFromMigration(projectName string, conn io.Re
 				return err
 			}
 
-			reverter.Add(func() { _ = VolumeDBDelete(b, projectName, newSnapshotName, vol.Type()) })
+			if !args.Resume {
+				reverter.Add(func() { _ = VolumeDBDelete(b, projectName, newSnapshotName, vol.Type()) })
+			}
 		}
 	}
 
-	if b.driver.Info().TargetFormat == drivers.BlockVolumeTypeQcow2 && (!b.driver.Info().Remote || args.ClusterMoveSourceName == "" || args.StoragePool != "") {
+	// On failure, only drop the records of what didn't make it to storage so the transfer can be resumed.
+	if args.Resume {
+		reverter.Add(func() { b.revertPartialCustomVolumeMigration(projectName, vol, args) })
+	}
+
+	if qcow2Target {
 		err = b.qcow2CreateVolumeFromMigration(vol, projectName, conn, args, nil, op)
 		if err != nil {
 			return err
@@ -5585,10 +5797,59 @@ func (b *backend) CreateCustomVolumeFromMigration(projectName string, conn io.Re
 
 	b.state.Events.SendLifecycle(projectName, lifecycle.StorageVolumeCreated.Event(vol, string(vol.Type()), projectName, op, eventCtx))
 
+	// The volume is now complete.
+	if args.Resume {
+		err = b.clearCustomVolumeMigrationPartial(projectName, args.Name)
+		if err != nil {
+			return err
+		}
+	}
+
 	reverter.Success()
 	return nil
 }
 
+// revertPartialCustomVolumeMigration removes the database records of a failed resumable migration
+// which have no matching volume on storage, keeping the completed snapshots.
+func (b *backend) revertPartialCustomVolumeMigration(projectName string, vol drivers.Volume, args localMigration.VolumeTargetArgs) {
+	for _, snapshot := range args.Snapshots {
+		snapVol, err := vol.NewSnapshot(snapshot.GetName())
+		if err != nil {
+			continue
+		}
+
+		exists, err := b.driver.HasVolume(snapVol)
+		if err != nil || !exists {
+			_ = VolumeDBDelete(b, projectName, drivers.GetSnapshotVolumeName(args.Name, snapshot.GetName()), vol.Type())
+		}
+	}
+
+	exists, err := b.driver.HasVolume(vol)
+	if !args.Refresh && (err != nil || !exists) {
+		_ = VolumeDBDelete(b, projectName, args.Name, vol.Type())
+	}
+}
+
+// clearCustomVolumeMigrationPartial removes the resumable migration marker from a custom volume.
+func (b *backend) clearCustomVolumeMigrationPartial(projectName string, volName string) error {
+	dbVol, err := VolumeDBGet(b, projectName, volName, drivers.VolumeTypeCustom)
+	if err != nil {
+		return err
+	}
+
+	_, ok := dbVol.Config[VolumeMigrationPartialKey]
+	if !ok {
+		return nil
+	}
+
+	newConfig := maps.Clone(dbVol.Config)
+	delete(newConfig, VolumeMigrationPartialKey)
+
+	return b.state.DB.Cluster.Transaction(context.TODO(), func(ctx context.Context, tx *db.ClusterTx) error {
+		return tx.UpdateStoragePoolVolume(ctx, projectName, volName, db.StoragePoolVolumeTypeCustom, b.ID(), dbVol.Description, newConfig)
+	})
+}
+
 // RenameCustomVolume renames a custom volume and its snapshots.
 func (b *backend) RenameCustomVolume(projectName string, volName string, newVolName string, op *operations.Operation) error {
 	l := b.logger.AddContext(logger.Ctx{"project": projectName, "volName": volName, "newVolName": newVolName})
@@ -6193,6 +6454,30 @@ func (b *backend) GetCustomVolumeDisk(projectName, volName string) (string, erro
 	return b.driver.GetVolumeDiskPath(vol)
 }
 
+// customVolumeCopySize returns a best-effort estimate of the bytes to transfer when copying a custom volume, or 0 if unknown.
+func customVolumeCopySize(srcPool Pool, projectName string, volName string, contentType drivers.ContentType, volSize int64) int64 {
+	// Block volumes are transferred in full.
+	if drivers.IsContentBlock(contentType) && volSize > 0 {
+		return volSize
+	}
+
+	usage, err := srcPool.GetCustomVolumeUsage(projectName, volName)
+	if err != nil {
+		return 0
+	}
+
+	if usage.Used > 0 {
+		return usage.Used
+	}
+
+	// Fall back to the volume size when the driver can't report usage.
+	if usage.Used < 0 && usage.Total > 0 {
+		return usage.Total
+	}
+
+	return 0
+}
+
 // GetCustomVolumeUsage returns the disk space used by the custom volume.
 func (b *backend) GetCustomVolumeUsage(projectName, volName string) (*VolumeUsage, error) {
 	err := b.isStatusReady()
@@ -133,7 +133,7 @@ func (b *mockBackend) CreateInstanceFromBackup(srcBackup backup.Info, srcData io
 }
 
 // CreateInstanceFromCopy creates an instance volume by copying another instance.
-func (b *mockBackend) CreateInstanceFromCopy(inst instance.Instance, src instance.Instance, snapshots bool, allowInconsistent bool, op *operations.Operation) error {
+func (b *mockBackend) CreateInstanceFromCopy(inst instance.Instance, src instance.Instance, snapshots bool, onlySnapshots []string, allowInconsistent bool, op *operations.Operation) error {
 	return nil
 }
 
@@ -348,6 +348,16 @@ func (b *mockBackend) CreateCustomVolumeFromCopy(projectName string, srcProjectN
 	return nil
 }
 
+// CloneCustomVolume creates a custom volume by cloning another volume.
+func (b *mockBackend) CloneCustomVolume(projectName string, srcProjectName string, volName string, desc string, config map[string]string, srcPoolName string, srcVolName string, snapshots bool, op *operations.Operation) (bool, error) {
+	return false, nil
+}
+
+// ConvertCustomVolumeFromCopy creates a custom volume of another content type by copying another volume.
+func (b *mockBackend) ConvertCustomVolumeFromCopy(projectName string, srcProjectName string, volName string, desc string, config map[string]string, srcPoolName string, srcVolName string, contentType drivers.ContentType, op *operations.Operation) error {
+	return nil
+}
+
 // RenameCustomVolume renames a custom volume.
 func (b *mockBackend) RenameCustomVolume(projectName string, volName string, newName string, op *operations.Operation) error {
 	return nil
@@ -439,6 +449,11 @@ func (b *mockBackend) CreateCustomVolumeFromBackup(srcBackup backup.Info, srcDat
 	return nil
 }
 
+// CreateCustomVolumeFromImage creates a custom volume from a container image.
+func (b *mockBackend) CreateCustomVolumeFromImage(projectName string, volName string, desc string, config map[string]string, fingerprint string, op *operations.Operation) error {
+	return nil
+}
+
 // CreateCustomVolumeFromISO creates a custom volume from an ISO image.
 func (b *mockBackend) CreateCustomVolumeFromISO(projectName string, volName string, srcData io.ReadSeeker, size int64, op *operations.Operation) error {
 	return nil
@@ -440,6 +440,11 @@ func (d *btrfs) CreateVolumeFromBackup(vol Volume, srcBackup backup.Info, srcDat
 	return nil, revertHook, nil
 }
 
+// cloneCopy returns whether CreateVolumeFromCopy uses a copy-on-write clone of the source.
+func (d *btrfs) cloneCopy(srcVol Volume, copySnapshots bool) bool {
+	return true
+}
+
 // CreateVolumeFromCopy provides same-pool volume copying functionality.
 func (d *btrfs) CreateVolumeFromCopy(vol Volume, srcVol Volume, copySnapshots bool, allowInconsistent bool, op *operations.Operation) error {
 	reverter := revert.New()
@@ -336,6 +336,22 @@ func (d *ceph) CreateVolumeFromBackup(vol Volume, srcBackup backup.Info, srcData
 	return genericVFSBackupUnpack(d, d.state.OS, vol, srcBackup.Snapshots, srcData, basePrefix, op)
 }
 
+// cloneCopy returns whether CreateVolumeFromCopy uses a copy-on-write clone of the source.
+func (d *ceph) cloneCopy(srcVol Volume, copySnapshots bool) bool {
+	if util.IsFalse(d.config["ceph.rbd.clone_copy"]) {
+		return false
+	}
+
+	// Volumes with snapshots are always copied in full.
+	if !copySnapshots || srcVol.IsSnapshot() {
+		return true
+	}
+
+	snapshots, err := d.VolumeSnapshots(srcVol, nil)
+
+	return err == nil && len(snapshots) == 0
+}
+
 // CreateVolumeFromCopy provides same-pool volume copying functionality.
 func (d *ceph) CreateVolumeFromCopy(vol Volume, srcVol Volume, copySnapshots bool, allowInconsistent bool, op *operations.Operation) error {
 	var err error
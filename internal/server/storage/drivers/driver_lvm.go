@@ -159,6 +159,7 @@ func (d *lvm) Info() Info {
 		VolumeTypes:                  []VolumeType{VolumeTypeBucket, VolumeTypeCustom, VolumeTypeImage, VolumeTypeContainer, VolumeTypeVM},
 		VolumeMultiNode:              d.isRemote(),
 		BlockBacking:                 true,
+		ThickBlockVolumes:            !d.usesThinpool(),
 		RunningCopyFreeze:            true,
 		SameSource:                   d.isRemote(),
 		DirectIO:                     true,
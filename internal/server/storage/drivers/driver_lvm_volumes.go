@@ -163,6 +163,11 @@ func (d *lvm) CreateVolumeFromBackup(vol Volume, srcBackup backup.Info, srcData
 	return genericVFSBackupUnpack(d, d.state.OS, vol, srcBackup.Snapshots, srcData, basePrefix, op)
 }
 
+// cloneCopy returns whether CreateVolumeFromCopy uses a copy-on-write clone of the source.
+func (d *lvm) cloneCopy(srcVol Volume, copySnapshots bool) bool {
+	return d.usesThinpool()
+}
+
 // CreateVolumeFromCopy provides same-pool volume copying functionality.
 func (d *lvm) CreateVolumeFromCopy(vol Volume, srcVol Volume, copySnapshots bool, allowInconsistent bool, op *operations.Operation) error {
 	var err error
@@ -14,6 +14,7 @@ type Info struct {
 	OptimizedBackupHeader        bool         // Whether driver generates an optimised backup header file in backup.
 	PreservesInodes              bool         // Whether driver preserves inodes when volumes are moved hosts.
 	BlockBacking                 bool         // Whether driver uses block devices as backing store.
+	ThickBlockVolumes            bool         // Whether block volumes have their full size allocated on creation.
 	RunningCopyFreeze            bool         // Whether instance should be frozen during snapshot if running.
 	SameSource                   bool         // Whether the storage pool config from the node that created the pool should be copied to all other cluster nodes.
 	DirectIO                     bool         // Whether the driver supports direct I/O.
@@ -86,8 +86,13 @@ func (d *zfs) createDataset(dataset string, options ...string) error {
 	return nil
 }
 
-func (d *zfs) createVolume(dataset string, size int64, options ...string) error {
-	args := []string{"create", "-s", "-V", fmt.Sprintf("%d", size)}
+func (d *zfs) createVolume(dataset string, size int64, sparse bool, options ...string) error {
+	args := []string{"create"}
+	if sparse {
+		args = append(args, "-s")
+	}
+
+	args = append(args, "-V", fmt.Sprintf("%d", size))
 	for _, option := range options {
 		args = append(args, "-o")
 		args = append(args, option)
@@ -103,6 +108,21 @@ func (d *zfs) createVolume(dataset string, size int64, options ...string) error
 	return nil
 }
 
+// applyBlockType makes an existing zvol sparse or fully reserved to match block.type.
+// Clones and received volumes don't get this from createVolume.
+func (d *zfs) applyBlockType(vol Volume) error {
+	if !IsContentBlock(vol.contentType) && !(d.isBlockBacked(vol) && vol.contentType == ContentTypeFS) {
+		return nil
+	}
+
+	refreservation := "none"
+	if vol.ExpandedConfig("block.type") == "thick" {
+		refreservation = "auto"
+	}
+
+	return d.setDatasetProperties(d.dataset(vol, false), "refreservation="+refreservation)
+}
+
 func (d *zfs) datasetExists(dataset string) (bool, error) {
 	out, err := subprocess.RunCommand("zfs", "get", "-H", "-o", "name", "name", dataset)
 	if err != nil {
@@ -228,8 +228,9 @@ func (d *zfs) CreateVolume(vol Volume, filler *VolumeFiller, op *operations.Oper
 			return err
 		}
 
-		// Create the volume dataset.
-		err = d.createVolume(d.dataset(vol, false), sizeBytes, opts...)
+		// Create the volume dataset, sparse unless thick provisioning was requested.
+		sparse := vol.ExpandedConfig("block.type") != "thick"
+		err = d.createVolume(d.dataset(vol, false), sizeBytes, sparse, opts...)
 		if err != nil {
 			return err
 		}
@@ -720,6 +721,22 @@ func (d *zfs) copyVolumeSnapshotsUsingSend(vol Volume, srcVol Volume, srcSnapsho
 	return nil
 }
 
+// cloneCopy returns whether CreateVolumeFromCopy uses a copy-on-write clone of the source.
+func (d *zfs) cloneCopy(srcVol Volume, copySnapshots bool) bool {
+	if util.IsFalse(d.config["zfs.clone_copy"]) {
+		return false
+	}
+
+	// Volumes with snapshots are always sent in full.
+	if !copySnapshots || srcVol.IsSnapshot() {
+		return true
+	}
+
+	snapshots, err := d.VolumeSnapshots(srcVol, nil)
+
+	return err == nil && len(snapshots) == 0
+}
+
 // CreateVolumeFromCopy provides same-pool volume copying functionality.
 func (d *zfs) CreateVolumeFromCopy(vol Volume, srcVol Volume, copySnapshots bool, allowInconsistent bool, op *operations.Operation) error {
 	var err error
@@ -1002,6 +1019,12 @@ func (d *zfs) CreateVolumeFromCopy(vol Volume, srcVol Volume, copySnapshots bool
 		return err
 	}
 
+	// Apply the requested provisioning to the copy.
+	err = d.applyBlockType(vol)
+	if err != nil {
+		return err
+	}
+
 	// All done.
 	reverter.Success()
 	return nil
@@ -1365,6 +1388,12 @@ func (d *zfs) createVolumeFromMigrationOptimized(vol Volume, conn io.ReadWriteCl
 		}
 	}
 
+	// Apply the requested provisioning to the received volume.
+	err = d.applyBlockType(vol)
+	if err != nil {
+		return err
+	}
+
 	reverter.Success()
 	return nil
 }
@@ -1708,6 +1737,15 @@ func (d *zfs) commonVolumeRules() map[string]func(value string) error {
 		//  shortdesc: Mount options for block-backed file system volumes
 		"block.mount_options": validate.IsAny,
 
+		// gendoc:generate(entity=storage_volume_zfs, group=common, key=block.type)
+		// Thick volumes have their full size reserved on creation.
+		// ---
+		//  type: string
+		//  condition: block-based volume
+		//  default: same as `volume.block.type` or `thin`
+		//  shortdesc: Whether to create a sparse (`thin`) or fully reserved (`thick`) `zvol`
+		"block.type": validate.Optional(validate.IsOneOf("thin", "thick")),
+
 		// gendoc:generate(entity=storage_volume_zfs, group=common, key=block.create_options)
 		//
 		// ---
@@ -1884,6 +1922,7 @@ func (d *zfs) ValidateVolume(vol Volume, removeUnknownKeys bool) error {
 	} else if vol.volType == VolumeTypeCustom && !vol.IsBlockBacked() {
 		delete(commonRules, "block.filesystem")
 		delete(commonRules, "block.mount_options")
+		delete(commonRules, "block.type")
 	}
 
 	return d.validateVolume(vol, commonRules, removeUnknownKeys)
@@ -3800,7 +3839,7 @@ func (d *zfs) FillVolumeConfig(vol Volume) error {
 	if vol.hasSource || vol.IsVMBlock() || vol.volType == VolumeTypeCustom && vol.contentType == ContentTypeBlock {
 		excludedKeys = []string{"zfs.block_mode", "block.filesystem", "block.mount_options", "block.create_options"}
 	} else if vol.volType == VolumeTypeCustom && !vol.IsBlockBacked() {
-		excludedKeys = []string{"block.filesystem", "block.mount_options", "block.create_options"}
+		excludedKeys = []string{"block.filesystem", "block.mount_options", "block.create_options", "block.type"}
 	}
 
 	err := d.fillVolumeConfig(&vol, excludedKeys...)
@@ -0,0 +1,40 @@
+package drivers
+
+import (
+	"testing"
+)
+
+// Test that a pool level block.type doesn't leak into filesystem custom volumes.
+func Test_zfs_FillVolumeConfig_BlockType(t *testing.T) {
+	d := &zfs{}
+	d.init(nil, "pool", map[string]string{"volume.block.type": "thick"}, nil, nil, &Validators{
+		VolumeRules: func(vol Volume) map[string]func(string) error { return map[string]func(string) error{} },
+	})
+
+	vol := NewVolume(d, "pool", VolumeTypeCustom, ContentTypeFS, "vol", map[string]string{}, d.config)
+
+	err := d.FillVolumeConfig(vol)
+	if err != nil {
+		t.Fatalf("Failed filling volume config: %v", err)
+	}
+
+	if vol.config["block.type"] != "" {
+		t.Fatalf("Unexpected block.type %q on a filesystem volume", vol.config["block.type"])
+	}
+
+	err = d.ValidateVolume(vol, false)
+	if err != nil {
+		t.Fatalf("Failed validating filesystem volume: %v", err)
+	}
+
+	blockVol := NewVolume(d, "pool", VolumeTypeCustom, ContentTypeBlock, "vol", map[string]string{}, d.config)
+
+	err = d.FillVolumeConfig(blockVol)
+	if err != nil {
+		t.Fatalf("Failed filling volume config: %v", err)
+	}
+
+	if blockVol.config["block.type"] != "thick" {
+		t.Fatalf("Expected block.type to be inherited by block volumes, got %q", blockVol.config["block.type"])
+	}
+}
@@ -311,7 +311,10 @@ func genericVFSCreateVolumeFromMigration(d Driver, initVolume func(vol Volume) (
 			return err
 		}
 
-		reverter.Add(func() { _ = d.DeleteVolume(vol, op) })
+		// A resumable transfer keeps what it received so far.
+		if !volTargetArgs.Resume {
+			reverter.Add(func() { _ = d.DeleteVolume(vol, op) })
+		}
 	}
 
 	recvFSVol := func(volName string, conn io.ReadWriteCloser, path string) error {
@@ -429,6 +432,15 @@ func genericVFSCreateVolumeFromMigration(d Driver, initVolume func(vol Volume) (
 				return err
 			}
 
+			if volTargetArgs.Resume {
+				// Record the checkpoint so an interrupted transfer can be resumed from here.
+				if op != nil {
+					_ = op.ExtendMetadata(map[string]any{"migration_checkpoint": snapshot.GetName()})
+				}
+
+				continue
+			}
+
 			// Setup the revert.
 			reverter.Add(func() {
 				_ = d.DeleteVolumeSnapshot(snapVol, op)
@@ -1331,3 +1331,15 @@ func UnpackVolume(d Driver, vol Volume, r io.ReadSeeker, tarArgs []string, unpac
 
 	return nil
 }
+
+// cloneCopier is implemented by drivers which can copy volumes within a pool using a copy-on-write clone.
+type cloneCopier interface {
+	cloneCopy(srcVol Volume, copySnapshots bool) bool
+}
+
+// IsCloneCopy returns whether copying srcVol within the driver's pool uses a copy-on-write clone rather than a full copy.
+func IsCloneCopy(d Driver, srcVol Volume, copySnapshots bool) bool {
+	cloner, ok := d.(cloneCopier)
+
+	return ok && cloner.cloneCopy(srcVol, copySnapshots)
+}
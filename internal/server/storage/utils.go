@@ -42,6 +42,9 @@ import (
 	"github.com/lxc/incus/v7/shared/validate"
 )
 
+// VolumeMigrationPartialKey marks a custom volume left behind by an interrupted resumable migration.
+const VolumeMigrationPartialKey = "volatile.migration.partial"
+
 // ConfigDiff returns a diff of the provided configs. Additionally, it returns whether or not
 // only user properties have been changed.
 func ConfigDiff(oldConfig map[string]string, newConfig map[string]string) ([]string, bool) {
@@ -564,6 +567,7 @@ func validateVolumeCommonRules(vol drivers.Volume) map[string]func(string) error
 
 	if vol.Type() == drivers.VolumeTypeCustom {
 		rules["dependent"] = validate.Optional(validate.IsBool)
+		rules[VolumeMigrationPartialKey] = validate.Optional(validate.IsBool)
 	}
 
 	return rules
@@ -1092,6 +1096,21 @@ func VolumeUsedByDaemon(s *state.State, poolName string, volumeName string) (str
 	return daemonVolumes[fmt.Sprintf("%s/%s", poolName, volumeName)], nil
 }
 
+// ValidateCustomVolumeConversion checks whether a custom volume can be copied into a volume of another content type.
+// Only ISO volumes can currently be converted, into raw block volumes.
+func ValidateCustomVolumeConversion(srcContentType drivers.ContentType, dstContentType drivers.ContentType) error {
+	if srcContentType == drivers.ContentTypeISO && dstContentType == drivers.ContentTypeBlock {
+		return nil
+	}
+
+	// A filesystem volume holds files rather than a disk image, so there is nothing to copy into a block device.
+	if (srcContentType == drivers.ContentTypeFS && dstContentType == drivers.ContentTypeBlock) || (srcContentType == drivers.ContentTypeBlock && dstContentType == drivers.ContentTypeFS) {
+		return fmt.Errorf("Converting between %q and %q volumes isn't supported, only %q volumes can be copied into %q volumes", drivers.ContentTypeFS, drivers.ContentTypeBlock, drivers.ContentTypeISO, drivers.ContentTypeBlock)
+	}
+
+	return fmt.Errorf("Converting a %q volume into a %q volume isn't supported", srcContentType, dstContentType)
+}
+
 // FallbackMigrationType returns the fallback migration transport to use based on volume content type.
 func FallbackMigrationType(contentType drivers.ContentType) migration.MigrationFSType {
 	if drivers.IsContentBlock(contentType) {
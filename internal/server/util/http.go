@@ -78,7 +78,24 @@ func EtagHash(data any) (string, error) {
 
 // EtagCheck validates the hash of the current state with the hash
 // provided by the client.
+// For GET and HEAD requests, a matching If-None-Match header results in a
+// StatusNotModified error.
 func EtagCheck(r *http.Request, data any) error {
+	noneMatch := r.Header.Get("If-None-Match")
+	if noneMatch != "" && (r.Method == http.MethodGet || r.Method == http.MethodHead) {
+		hash, err := EtagHash(data)
+		if err != nil {
+			return err
+		}
+
+		for _, tag := range strings.Split(noneMatch, ",") {
+			tag = strings.Trim(strings.TrimPrefix(strings.TrimSpace(tag), "W/"), "\"")
+			if tag == "*" || tag == hash {
+				return api.StatusErrorf(http.StatusNotModified, "ETag matches: %s", hash)
+			}
+		}
+	}
+
 	match := r.Header.Get("If-Match")
 	if match == "" {
 		return nil
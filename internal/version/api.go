@@ -554,6 +554,37 @@ var APIExtensions = []string{
 	"projects_restricted_virtual_machines_nesting",
 	"authorization_config",
 	"network_allocations_network",
+	"storage_volumes_bulk_delete",
+	"storage_volume_snapshot_expiry_report",
+	"storage_volume_move_refresh",
+	"storage_volume_from_image",
+	"storage_volume_snapshots_etag",
+	"instance_refresh_from_snapshot",
+	"instance_copy_snapshot_selection",
+	"storage_volume_copy_progress",
+	"storage_volume_next_snapshot",
+	"storage_volumes_pagination",
+	"storage_volume_copy_content_type",
+	"storage_volumes_include_location",
+	"storage_volume_snapshots_prune",
+	"storage_volume_snapshots_interval",
+	"storage_volume_snapshot_expires_in",
+	"storage_volume_clone",
+	"storage_volume_usedby_type",
+	"storage_volume_schedule_preview",
+	"storage_volume_snapshot_pattern",
+	"storage_volume_delete_force",
+	"storage_volume_migration_limits",
+	"storage_volume_migration_verify",
+	"storage_zfs_block_type",
+	"storage_volume_snapshots_summary",
+	"storage_volume_snapshot_past_expiry",
+	"storage_volumes_member_filter",
+	"storage_volume_snapshots_delay",
+	"storage_volume_snapshot_idempotency_key",
+	"storage_volume_state_not_implemented",
+	"storage_volume_snapshot_preview_expiry",
+	"storage_volume_migration_resume",
 }
 
 // APIExtensionsCount returns the number of available API extensions.
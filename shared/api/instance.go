@@ -127,6 +127,12 @@ type InstancePost struct {
 	//
 	// API extension: instance_move_config
 	Profiles []string
+
+	// Names of the snapshots to send (migration only, defaults to all)
+	// Example: ["snap0", "snap1"]
+	//
+	// API extension: instance_copy_snapshot_selection
+	Snapshots []string `json:"snapshots,omitempty" yaml:"snapshots,omitempty"`
 }
 
 // InstancePostTarget represents the migration target host and operation.
@@ -381,4 +387,10 @@ type InstanceSource struct {
 	//
 	// API extension: instance_allow_inconsistent_copy
 	AllowInconsistent bool `json:"allow_inconsistent" yaml:"allow_inconsistent"`
+
+	// Names of the source snapshots to copy (defaults to all)
+	// Example: ["snap0", "snap1"]
+	//
+	// API extension: instance_copy_snapshot_selection
+	Snapshots []string `json:"snapshots,omitempty" yaml:"snapshots,omitempty"`
 }
@@ -75,6 +75,12 @@ type StorageVolumePost struct {
 	//
 	// API extension: cluster_internal_custom_volume_copy
 	Source StorageVolumeSource `json:"source" yaml:"source"`
+
+	// Whether to refresh an existing target volume rather than fail (move only)
+	// Example: false
+	//
+	// API extension: storage_volume_move_refresh
+	Refresh bool `json:"refresh,omitempty" yaml:"refresh,omitempty"`
 }
 
 // StorageVolumePostTarget represents the migration target host and operation
@@ -96,6 +102,35 @@ type StorageVolumePostTarget struct {
 	Websockets map[string]string `json:"secrets,omitempty" yaml:"secrets,omitempty"`
 }
 
+// StorageVolumesBulkDeletePost represents a request to delete multiple storage volumes
+//
+// swagger:model
+//
+// API extension: storage_volumes_bulk_delete.
+type StorageVolumesBulkDeletePost struct {
+	// List of volumes to delete
+	Volumes []StorageVolumeBulkDeleteEntry `json:"volumes" yaml:"volumes"`
+}
+
+// StorageVolumeBulkDeleteEntry identifies a storage volume to be deleted
+//
+// swagger:model
+//
+// API extension: storage_volumes_bulk_delete.
+type StorageVolumeBulkDeleteEntry struct {
+	// Volume type (custom or image)
+	// Example: custom
+	Type string `json:"type" yaml:"type"`
+
+	// Volume name
+	// Example: foo
+	Name string `json:"name" yaml:"name"`
+
+	// Project name (defaults to the request project)
+	// Example: default
+	Project string `json:"project,omitempty" yaml:"project,omitempty"`
+}
+
 // StorageVolumeFull is a combination of StorageVolume, StorageVolumeBackup, StorageVolumeSnapshot and StorageVolumeState.
 //
 // swagger:model
@@ -156,6 +191,30 @@ type StorageVolume struct {
 	// Example: 2021-03-23T20:00:00-04:00
 	// API extension: storage_volumes_created_at
 	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+
+	// Time of the next scheduled snapshot (only set when snapshots.schedule is configured)
+	// Example: 2021-03-23T20:00:00-04:00
+	//
+	// API extension: storage_volume_next_snapshot
+	NextSnapshotAt *time.Time `json:"next_snapshot_at,omitempty" yaml:"next_snapshot_at,omitempty"`
+
+	// Upcoming scheduled snapshot times (only set when requested with schedule-preview)
+	// Example: ["2021-03-23T20:00:00-04:00"]
+	//
+	// API extension: storage_volume_schedule_preview
+	SnapshotSchedulePreview []time.Time `json:"snapshot_schedule_preview,omitempty" yaml:"snapshot_schedule_preview,omitempty"`
+
+	// Number of snapshots of the volume (only set when requested with include-snapshots-summary)
+	// Example: 3
+	//
+	// API extension: storage_volume_snapshots_summary
+	SnapshotCount *int `json:"snapshot_count,omitempty" yaml:"snapshot_count,omitempty"`
+
+	// Creation time of the most recent snapshot (only set when requested with include-snapshots-summary)
+	// Example: 2021-03-23T20:00:00-04:00
+	//
+	// API extension: storage_volume_snapshots_summary
+	LatestSnapshotAt *time.Time `json:"latest_snapshot_at,omitempty" yaml:"latest_snapshot_at,omitempty"`
 }
 
 // URL returns the URL for the volume.
@@ -172,6 +231,21 @@ func (v *StorageVolume) URL(apiVersion string, poolName string) *URL {
 	return u.Project(v.Project).Target(v.Location)
 }
 
+// StorageVolumeLocation represents the URL of a storage volume and the cluster member hosting it
+//
+// swagger:model
+//
+// API extension: storage_volumes_include_location.
+type StorageVolumeLocation struct {
+	// URL of the storage volume
+	// Example: /1.0/storage-pools/local/volumes/custom/foo
+	URL string `json:"url" yaml:"url"`
+
+	// Cluster member hosting the volume (empty for remote pools or standalone servers)
+	// Example: server01
+	Location string `json:"location" yaml:"location"`
+}
+
 // StorageVolumePut represents the modifiable fields of a storage volume
 //
 // swagger:model
@@ -205,7 +279,7 @@ type StorageVolumeSource struct {
 	// Example: foo
 	Name string `json:"name" yaml:"name"`
 
-	// Source type (copy or migration)
+	// Source type (copy, clone, migration or image)
 	// Example: copy
 	Type string `json:"type" yaml:"type"`
 
@@ -266,6 +340,42 @@ type StorageVolumeSource struct {
 	//
 	// API extension: cluster_internal_custom_volume_copy
 	Location string `json:"location" yaml:"location"`
+
+	// Image fingerprint (for image)
+	// Example: 06b86454720d36b20f94e31c6812e05ec51c1b568cf3a8abd273769d213394bb
+	//
+	// API extension: storage_volume_from_image
+	Fingerprint string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+
+	// Remote server URL (for remote images)
+	// Example: https://images.linuxcontainers.org
+	//
+	// API extension: storage_volume_from_image
+	Server string `json:"server,omitempty" yaml:"server,omitempty"`
+
+	// Remote server protocol (for remote images)
+	// Example: simplestreams
+	//
+	// API extension: storage_volume_from_image
+	Protocol string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+
+	// Maximum transfer rate in bytes per second (for migration, 0 means unlimited)
+	// Example: 104857600
+	//
+	// API extension: storage_volume_migration_limits
+	Limits int64 `json:"limits,omitempty" yaml:"limits,omitempty"`
+
+	// Whether to verify a checksum of the transferred data (for migration, on either end)
+	// Example: false
+	//
+	// API extension: storage_volume_migration_verify
+	VerifyTransfer bool `json:"verify_transfer,omitempty" yaml:"verify_transfer,omitempty"`
+
+	// Whether to resume an interrupted migration into an existing target volume (for migration only)
+	// Example: false
+	//
+	// API extension: storage_volume_migration_resume
+	Resume bool `json:"resume,omitempty" yaml:"resume,omitempty"`
 }
 
 // Writable converts a full StorageVolume struct into a StorageVolumePut struct (filters read-only fields).
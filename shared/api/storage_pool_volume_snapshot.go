@@ -19,6 +19,18 @@ type StorageVolumeSnapshotsPost struct {
 	//
 	// API extension: custom_volume_snapshot_expiry
 	ExpiresAt *time.Time `json:"expires_at" yaml:"expires_at"`
+
+	// Time span after which the snapshot expires (ignored when expires_at is set)
+	// Example: 2d
+	//
+	// API extension: storage_volume_snapshot_expires_in
+	ExpiresIn string `json:"expires_in,omitempty" yaml:"expires_in,omitempty"`
+
+	// Snapshot name pattern overriding snapshots.pattern for this snapshot (ignored when name is set)
+	// Example: manual-%d
+	//
+	// API extension: storage_volume_snapshot_pattern
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
 }
 
 // StorageVolumeSnapshotPost represents the fields required to rename/move a storage volume snapshot
@@ -71,6 +83,33 @@ type StorageVolumeSnapshot struct {
 	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
 }
 
+// StorageVolumeSnapshotExpiry represents the computed expiry of a storage volume snapshot
+//
+// swagger:model
+//
+// API extension: storage_volume_snapshot_expiry_report.
+type StorageVolumeSnapshotExpiry struct {
+	// Snapshot name
+	// Example: snap0
+	Name string `json:"name" yaml:"name"`
+
+	// Expiry date of the snapshot (RFC3339) or "never"
+	// Example: 2021-03-23T17:38:37.753398689-04:00
+	Expiry string `json:"expiry" yaml:"expiry"`
+
+	// When the snapshot expires (unset if it never does)
+	// Example: 2021-03-23T17:38:37.753398689-04:00
+	ExpiresAt *time.Time `json:"expires_at" yaml:"expires_at"`
+
+	// Whether the snapshot will be removed on the next run of the expiry task
+	// Example: false
+	PendingRemoval bool `json:"pending_removal" yaml:"pending_removal"`
+
+	// Cluster member which will perform the removal (empty if none can be selected)
+	// Example: server01
+	Location string `json:"location" yaml:"location"`
+}
+
 // StorageVolumeSnapshotPut represents the modifiable fields of a storage volume
 //
 // swagger:model
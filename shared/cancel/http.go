@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"sync"
+	"time"
 )
 
 // HTTPRequestCanceller tracks a cancelable operation.
@@ -79,3 +80,34 @@ func CancelableDownload(c *HTTPRequestCanceller, do func(req *http.Request) (*ht
 
 	return resp, chDone, nil
 }
+
+// CancelableWait waits for the given duration and allows for the wait to be canceled at any time.
+func CancelableWait(ctx context.Context, c *HTTPRequestCanceller, d time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Register a placeholder request so that the wait counts as cancelable.
+	if c != nil {
+		req := &http.Request{}
+
+		c.lock.Lock()
+		c.reqCancel[req] = cancel
+		c.lock.Unlock()
+
+		defer func() {
+			c.lock.Lock()
+			delete(c.reqCancel, req)
+			c.lock.Unlock()
+		}()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
 	"slices"
 	"strings"
@@ -39,6 +40,7 @@ type Remote struct {
 	Project         string     `yaml:"project,omitempty"`
 	Protocol        string     `yaml:"protocol,omitempty"`
 	CredHelper      string     `yaml:"credentials_helper,omitempty"`
+	Keyring         string     `yaml:"keyring,omitempty"`
 	Public          bool       `yaml:"public"`
 	Global          bool       `yaml:"-"`
 	Static          bool       `yaml:"-"`
@@ -270,6 +272,21 @@ func (c *Config) getImageServer(args *incus.ConnectionArgs, remote Remote, addr
 
 	// HTTPs (simplestreams)
 	if remote.Protocol == "simplestreams" {
+		// Require a signed index if the remote has a keyring configured.
+		if remote.Keyring != "" {
+			keyringPath := remote.Keyring
+			if !filepath.IsAbs(keyringPath) {
+				keyringPath = c.ConfigPath(keyringPath)
+			}
+
+			keyring, err := os.ReadFile(keyringPath)
+			if err != nil {
+				return nil, fmt.Errorf("Failed reading keyring for remote: %w", err)
+			}
+
+			args.SimpleStreamsKeyring = string(keyring)
+		}
+
 		d, err := incus.ConnectSimpleStreams(addr, args)
 		if err != nil {
 			return nil, err
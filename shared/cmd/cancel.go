@@ -13,6 +13,12 @@ import (
 
 // CancelableWait waits for an operation and cancel it on SIGINT/SIGTERM.
 func CancelableWait(rawOp any, progress *ProgressRenderer) error {
+	return CancelableWaitTimeout(rawOp, progress, 0)
+}
+
+// CancelableWaitTimeout is like CancelableWait but also cancels the operation once the timeout is reached.
+// A zero timeout waits forever.
+func CancelableWaitTimeout(rawOp any, progress *ProgressRenderer, timeout time.Duration) error {
 	var op incus.Operation
 	var rop incus.RemoteOperation
 
@@ -26,10 +32,27 @@ func CancelableWait(rawOp any, progress *ProgressRenderer) error {
 		return errors.New("Invalid operation type for CancelableWait")
 	}
 
+	cancelOp := func() error {
+		if op != nil {
+			return op.Cancel()
+		}
+
+		return rop.CancelTarget()
+	}
+
 	// Signal handling
 	chSignal := make(chan os.Signal, 1)
 	signal.Notify(chSignal, os.Interrupt)
 
+	// Timeout handling
+	var chTimeout <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		chTimeout = timer.C
+	}
+
 	// Operation handling
 	chOperation := make(chan error)
 	go func() {
@@ -49,13 +72,15 @@ func CancelableWait(rawOp any, progress *ProgressRenderer) error {
 		select {
 		case err := <-chOperation:
 			return err
-		case <-chSignal:
-			if op != nil {
-				err = op.Cancel()
-			} else {
-				err = rop.CancelTarget()
+		case <-chTimeout:
+			err = cancelOp()
+			if err != nil {
+				return fmt.Errorf(i18n.G("Operation timed out after %s and couldn't be canceled: %w"), timeout, err)
 			}
 
+			return fmt.Errorf(i18n.G("Operation timed out after %s"), timeout)
+		case <-chSignal:
+			err = cancelOp()
 			if err == nil {
 				return errors.New(i18n.G("Remote operation canceled by user"))
 			}
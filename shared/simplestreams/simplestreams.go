@@ -1,6 +1,7 @@
 package simplestreams
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+
 	"github.com/lxc/incus/v7/shared/api"
 	"github.com/lxc/incus/v7/shared/logger"
 	"github.com/lxc/incus/v7/shared/osarch"
@@ -58,6 +62,8 @@ type SimpleStreams struct {
 
 	cachePath   string
 	cacheExpiry time.Duration
+
+	keyring openpgp.EntityList
 }
 
 // SetCache configures the on-disk cache.
@@ -66,6 +72,19 @@ func (s *SimpleStreams) SetCache(path string, expiry time.Duration) {
 	s.cacheExpiry = expiry
 }
 
+// SetKeyring requires the stream index and products to be signed by a key from the armored keyring.
+// Once set, the signed (.sjson) files are used and any missing or invalid signature is an error.
+func (s *SimpleStreams) SetKeyring(keyring string) error {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(keyring))
+	if err != nil {
+		return fmt.Errorf("Failed parsing simplestreams keyring: %w", err)
+	}
+
+	s.keyring = entities
+
+	return nil
+}
+
 func (s *SimpleStreams) readCache(path string) ([]byte, bool) {
 	cacheName := filepath.Join(s.cachePath, path)
 
@@ -178,13 +197,39 @@ func (s *SimpleStreams) cachedDownload(path string) ([]byte, error) {
 	return body, nil
 }
 
+// download returns the content of a stream file, verifying its signature if a keyring is set.
+func (s *SimpleStreams) download(path string) ([]byte, error) {
+	if s.keyring == nil {
+		return s.cachedDownload(path)
+	}
+
+	signedPath := strings.TrimSuffix(path, ".json") + ".sjson"
+
+	body, err := s.cachedDownload(signedPath)
+	if err != nil {
+		return nil, fmt.Errorf("Failed fetching signed stream file %q: %w", signedPath, err)
+	}
+
+	block, _ := clearsign.Decode(body)
+	if block == nil {
+		return nil, fmt.Errorf("Stream file %q isn't signed", signedPath)
+	}
+
+	_, err = openpgp.CheckDetachedSignature(s.keyring, bytes.NewReader(block.Bytes), block.ArmoredSignature.Body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid signature on stream file %q: %w", signedPath, err)
+	}
+
+	return block.Plaintext, nil
+}
+
 func (s *SimpleStreams) parseStream() (*Stream, error) {
 	if s.cachedStream != nil {
 		return s.cachedStream, nil
 	}
 
 	path := "streams/v1/index.json"
-	body, err := s.cachedDownload(path)
+	body, err := s.download(path)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +253,7 @@ func (s *SimpleStreams) parseProducts(path string) (*Products, error) {
 		return s.cachedProducts[path], nil
 	}
 
-	body, err := s.cachedDownload(path)
+	body, err := s.download(path)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,111 @@
+package simplestreams
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/clearsign"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+func newTestEntity(t *testing.T) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	if err != nil {
+		t.Fatalf("Failed generating key: %v", err)
+	}
+
+	return entity
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	buf := &bytes.Buffer{}
+	w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("Failed armoring key: %v", err)
+	}
+
+	err = entity.Serialize(w)
+	if err != nil {
+		t.Fatalf("Failed serializing key: %v", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		t.Fatalf("Failed armoring key: %v", err)
+	}
+
+	return buf.String()
+}
+
+func clearsignContent(t *testing.T, entity *openpgp.Entity, content string) []byte {
+	buf := &bytes.Buffer{}
+	w, err := clearsign.Encode(buf, entity.PrivateKey, nil)
+	if err != nil {
+		t.Fatalf("Failed signing content: %v", err)
+	}
+
+	_, err = w.Write([]byte(content))
+	if err != nil {
+		t.Fatalf("Failed signing content: %v", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		t.Fatalf("Failed signing content: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestDownloadSigned(t *testing.T) {
+	signer := newTestEntity(t)
+	other := newTestEntity(t)
+	content := `{"format": "index:1.0"}`
+
+	tests := []struct {
+		name    string
+		body    []byte
+		wantErr bool
+	}{
+		{"Valid signature", clearsignContent(t, signer, content), false},
+		{"Missing signature", []byte(content), true},
+		{"Bad signature", clearsignContent(t, other, content), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "index.sjson"), test.body, 0o644)
+			if err != nil {
+				t.Fatalf("Failed writing index: %v", err)
+			}
+
+			s := NewLocalClient(dir)
+			err = s.SetKeyring(armoredPublicKey(t, signer))
+			if err != nil {
+				t.Fatalf("Failed setting keyring: %v", err)
+			}
+
+			body, err := s.download(filepath.Join(dir, "index.json"))
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if string(body) != content {
+				t.Fatalf("Unexpected content: %q", body)
+			}
+		})
+	}
+}
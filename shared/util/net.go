@@ -18,6 +18,10 @@ import (
 // can not be found (404 HTTP status code).
 var ErrNotFound = errors.New("resource not found")
 
+// ErrServerError is used to signal a download failing with a server side
+// error (5xx HTTP status code), which may be worth retrying.
+var ErrServerError = errors.New("server error")
+
 // DownloadFileHash downloads a file while validating its hash.
 func DownloadFileHash(ctx context.Context, httpClient *http.Client, useragent string, progress func(progress ioprogress.ProgressData), canceler *cancel.HTTPRequestCanceller, filename string, url string, fileHash string, hashFunc hash.Hash, target io.WriteSeeker) (int64, error) {
 	// Always seek to the beginning
@@ -55,26 +59,15 @@ func DownloadFileHash(ctx context.Context, httpClient *http.Client, useragent st
 			return -1, fmt.Errorf("Unable to fetch %s: %w", url, ErrNotFound)
 		}
 
+		if r.StatusCode >= http.StatusInternalServerError {
+			return -1, fmt.Errorf("Unable to fetch %s: %s: %w", url, r.Status, ErrServerError)
+		}
+
 		return -1, fmt.Errorf("Unable to fetch %s: %s", url, r.Status)
 	}
 
 	// Handle the data
-	body := r.Body
-	if progress != nil {
-		body = &ioprogress.ProgressReader{
-			ReadCloser: r.Body,
-			Tracker: &ioprogress.ProgressTracker{
-				Length: r.ContentLength,
-				Handler: func(percent int64, speed int64) {
-					if filename != "" {
-						progress(ioprogress.ProgressData{Text: fmt.Sprintf("%s: %d%% (%s/s)", filename, percent, units.GetByteSizeString(speed, 2))})
-					} else {
-						progress(ioprogress.ProgressData{Text: fmt.Sprintf("%d%% (%s/s)", percent, units.GetByteSizeString(speed, 2))})
-					}
-				},
-			},
-		}
-	}
+	body := downloadProgressBody(r, filename, progress)
 
 	var size int64
 
@@ -97,3 +90,126 @@ func DownloadFileHash(ctx context.Context, httpClient *http.Client, useragent st
 
 	return size, nil
 }
+
+// DownloadFileHashResume is like DownloadFileHash but continues from the data already present in target.
+// The existing data is re-read to seed the hash and the remainder is requested through an HTTP range request.
+// If the server doesn't honor the range or the resulting hash doesn't match, the target is truncated and the
+// whole file is downloaded again, so the target must also implement Truncate.
+func DownloadFileHashResume(ctx context.Context, httpClient *http.Client, useragent string, progress func(progress ioprogress.ProgressData), canceler *cancel.HTTPRequestCanceller, filename string, url string, fileHash string, hashFunc hash.Hash, target io.ReadWriteSeeker) (int64, error) {
+	// Nothing to resume without a hash to validate the complete file against.
+	if hashFunc == nil {
+		return DownloadFileHash(ctx, httpClient, useragent, progress, canceler, filename, url, fileHash, hashFunc, target)
+	}
+
+	offset, err := target.Seek(0, io.SeekEnd)
+	if err != nil || offset == 0 {
+		return DownloadFileHash(ctx, httpClient, useragent, progress, canceler, filename, url, fileHash, hashFunc, target)
+	}
+
+	truncater, ok := target.(interface{ Truncate(size int64) error })
+	if !ok {
+		return -1, errors.New("Resuming a download requires a target that can be truncated")
+	}
+
+	// Start over from an empty target so no stale data is left past the end of the new file.
+	restart := func() (int64, error) {
+		hashFunc.Reset()
+
+		err := truncater.Truncate(0)
+		if err != nil {
+			return -1, err
+		}
+
+		return DownloadFileHash(ctx, httpClient, useragent, progress, canceler, filename, url, fileHash, hashFunc, target)
+	}
+
+	// Seed the hash from the data already downloaded.
+	_, err = target.Seek(0, io.SeekStart)
+	if err != nil {
+		return -1, err
+	}
+
+	_, err = SafeCopy(hashFunc, io.LimitReader(target, offset))
+	if err != nil {
+		return -1, err
+	}
+
+	// Prepare the range request.
+	var req *http.Request
+
+	if ctx != nil {
+		req, err = http.NewRequestWithContext(ctx, "GET", url, nil)
+	} else {
+		req, err = http.NewRequest("GET", url, nil)
+	}
+
+	if err != nil {
+		return -1, err
+	}
+
+	if useragent != "" {
+		req.Header.Set("User-Agent", useragent)
+	}
+
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+
+	// Perform the request.
+	r, doneCh, err := cancel.CancelableDownload(canceler, httpClient.Do, req)
+	if err != nil {
+		return -1, err
+	}
+
+	// Fall back to a full download if the range wasn't honored.
+	if r.StatusCode != http.StatusPartialContent {
+		_ = r.Body.Close()
+		close(doneCh)
+
+		return restart()
+	}
+
+	body := downloadProgressBody(r, filename, progress)
+
+	_, err = target.Seek(offset, io.SeekStart)
+	if err != nil {
+		_ = r.Body.Close()
+		close(doneCh)
+
+		return -1, err
+	}
+
+	size, err := SafeCopy(io.MultiWriter(target, hashFunc), body)
+	_ = r.Body.Close()
+	close(doneCh)
+	if err != nil {
+		return -1, err
+	}
+
+	// The existing data may have been bad, start over.
+	result := fmt.Sprintf("%x", hashFunc.Sum(nil))
+	if result != fileHash {
+		return restart()
+	}
+
+	return offset + size, nil
+}
+
+// downloadProgressBody wraps the response body with a progress tracker when a progress handler is set.
+func downloadProgressBody(r *http.Response, filename string, progress func(progress ioprogress.ProgressData)) io.ReadCloser {
+	if progress == nil {
+		return r.Body
+	}
+
+	return &ioprogress.ProgressReader{
+		ReadCloser: r.Body,
+		Tracker: &ioprogress.ProgressTracker{
+			Length: r.ContentLength,
+			Handler: func(percent int64, speed int64) {
+				if filename != "" {
+					progress(ioprogress.ProgressData{Text: fmt.Sprintf("%s: %d%% (%s/s)", filename, percent, units.GetByteSizeString(speed, 2))})
+				} else {
+					progress(ioprogress.ProgressData{Text: fmt.Sprintf("%d%% (%s/s)", percent, units.GetByteSizeString(speed, 2))})
+				}
+			},
+		},
+	}
+}